@@ -0,0 +1,260 @@
+package ics
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	utcFormat   = "20060102T150405Z"
+	localFormat = "20060102T150405"
+	dateFormat  = "20060102"
+
+	// maxLineLength is the maximum length of a content line, in octets,
+	// before it is folded.
+	maxLineLength = 75
+
+	// recurringCoverage is how far past its start the VTIMEZONE of a
+	// recurring event without UNTIL covers the offsets of its location.
+	recurringCoverage = 10 * 365 * 24 * time.Hour
+)
+
+// Marshal returns a VCALENDAR holding the given events.
+func Marshal(events ...Event) []byte {
+	var b strings.Builder
+	writeLine(&b, "BEGIN:VCALENDAR")
+	writeLine(&b, "VERSION:2.0")
+	writeLine(&b, "PRODID:-//go-pingdom//ics//EN")
+	for _, tz := range timezones(events) {
+		tz.encode(&b)
+	}
+	for _, e := range events {
+		e.encode(&b, time.Now())
+	}
+	writeLine(&b, "END:VCALENDAR")
+	return []byte(b.String())
+}
+
+func (e Event) encode(b *strings.Builder, now time.Time) {
+	stamp := e.Stamp
+	if stamp.IsZero() {
+		stamp = now
+	}
+
+	writeLine(b, "BEGIN:VEVENT")
+	writeLine(b, "UID:"+escapeText(e.UID))
+	writeLine(b, "DTSTAMP:"+stamp.UTC().Format(utcFormat))
+	writeLine(b, "DTSTART"+encodeTime(e.Start))
+	writeLine(b, "DTEND"+encodeTime(e.End))
+	if e.Summary != "" {
+		writeLine(b, "SUMMARY:"+escapeText(e.Summary))
+	}
+	if e.Description != "" {
+		writeLine(b, "DESCRIPTION:"+escapeText(e.Description))
+	}
+	if e.RRule != nil {
+		writeLine(b, "RRULE:"+e.RRule.String())
+	}
+	if len(e.UptimeIDs) != 0 {
+		writeLine(b, "X-PINGDOM-UPTIMEIDS:"+joinInts(e.UptimeIDs))
+	}
+	if len(e.TmsIDs) != 0 {
+		writeLine(b, "X-PINGDOM-TMSIDS:"+joinInts(e.TmsIDs))
+	}
+	writeLine(b, "END:VEVENT")
+}
+
+// String returns the rule in the form of the RRULE property value.
+func (r RRule) String() string {
+	parts := []string{"FREQ=" + r.Freq}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if !r.Until.IsZero() {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format(utcFormat))
+	}
+	if r.Count != 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	for _, name := range sortedKeys(r.Parts) {
+		parts = append(parts, name+"="+r.Parts[name])
+	}
+	return strings.Join(parts, ";")
+}
+
+// encodeTime returns the parameters and value of a DTSTART or DTEND.
+// Times in time.Local are encoded in UTC, as their location has no IANA
+// name.
+func encodeTime(t time.Time) string {
+	if !hasTZID(t) {
+		return ":" + t.UTC().Format(utcFormat)
+	}
+	return ";TZID=" + t.Location().String() + ":" + t.Format(localFormat)
+}
+
+func hasTZID(t time.Time) bool {
+	name := t.Location().String()
+	return name != "UTC" && name != "Local" && name != ""
+}
+
+// timezone is a VTIMEZONE, covering the offsets of loc between from and to.
+type timezone struct {
+	loc      *time.Location
+	from, to time.Time
+}
+
+// timezones returns a VTIMEZONE for every TZID used by the events, in
+// order of first use, covering the events' start to their end or the end
+// of their recurrence.
+func timezones(events []Event) []*timezone {
+	var zones []*timezone
+	byName := map[string]*timezone{}
+	for _, e := range events {
+		if !hasTZID(e.Start) {
+			continue
+		}
+		to := e.End
+		if e.RRule != nil {
+			if !e.RRule.Until.IsZero() {
+				to = laterOf(to, e.RRule.Until)
+			} else {
+				to = laterOf(to, e.Start.Add(recurringCoverage))
+			}
+		}
+
+		name := e.Start.Location().String()
+		tz, ok := byName[name]
+		if !ok {
+			tz = &timezone{loc: e.Start.Location(), from: e.Start, to: to}
+			byName[name] = tz
+			zones = append(zones, tz)
+			continue
+		}
+		if e.Start.Before(tz.from) {
+			tz.from = e.Start
+		}
+		tz.to = laterOf(tz.to, to)
+	}
+	return zones
+}
+
+// encode writes the VTIMEZONE with one observance for the offset at its
+// start and one for every transition up to its end.
+func (tz *timezone) encode(b *strings.Builder) {
+	writeLine(b, "BEGIN:VTIMEZONE")
+	writeLine(b, "TZID:"+tz.loc.String())
+	start := tz.from.In(tz.loc)
+	_, offset := start.Zone()
+	writeObservance(b, start, offset)
+	for _, t := range transitions(start, tz.to) {
+		writeObservance(b, t, offset)
+		_, offset = t.Zone()
+	}
+	writeLine(b, "END:VTIMEZONE")
+}
+
+// writeObservance writes the STANDARD or DAYLIGHT component of the offset
+// that is in effect from t, following the given offset.
+func writeObservance(b *strings.Builder, t time.Time, from int) {
+	kind := "STANDARD"
+	if t.IsDST() {
+		kind = "DAYLIGHT"
+	}
+	name, offset := t.Zone()
+
+	writeLine(b, "BEGIN:"+kind)
+	// DTSTART of an observance is the local time in the offset before it.
+	writeLine(b, "DTSTART:"+t.UTC().Add(time.Duration(from)*time.Second).Format(localFormat))
+	writeLine(b, "TZOFFSETFROM:"+formatOffset(from))
+	writeLine(b, "TZOFFSETTO:"+formatOffset(offset))
+	writeLine(b, "TZNAME:"+escapeText(name))
+	writeLine(b, "END:"+kind)
+}
+
+// transitions returns the instants after from and up to to at which the
+// offset of from's location changes.  It steps a day at a time, and so
+// misses transitions that are undone within a day.
+func transitions(from, to time.Time) []time.Time {
+	var result []time.Time
+	to = to.In(from.Location())
+	_, offset := from.Zone()
+	for prev := from; prev.Before(to); {
+		next := prev.Add(24 * time.Hour)
+		if next.After(to) {
+			next = to
+		}
+		if _, o := next.Zone(); o != offset {
+			// Find the first second with the new offset.
+			lo, hi := prev, next
+			for hi.Sub(lo) > time.Second {
+				mid := lo.Add(hi.Sub(lo) / 2).Truncate(time.Second)
+				if _, o := mid.Zone(); o == offset {
+					lo = mid
+				} else {
+					hi = mid
+				}
+			}
+			result = append(result, hi)
+			offset = o
+		}
+		prev = next
+	}
+	return result
+}
+
+// formatOffset formats a UTC offset in seconds as a UTC-OFFSET value, such
+// as +0100.
+func formatOffset(offset int) string {
+	sign := "+"
+	if offset < 0 {
+		sign = "-"
+		offset = -offset
+	}
+	s := fmt.Sprintf("%s%02d%02d", sign, offset/3600, offset/60%60)
+	if offset%60 != 0 {
+		s += fmt.Sprintf("%02d", offset%60)
+	}
+	return s
+}
+
+func laterOf(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
+
+func escapeText(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(s)
+}
+
+// writeLine writes a content line, folded so that no line is longer than
+// maxLineLength octets.  Lines are not split inside a UTF-8 sequence.
+func writeLine(b *strings.Builder, line string) {
+	limit := maxLineLength
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !isRuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut] + "\r\n ")
+		line = line[cut:]
+		// Continuation lines start with a space.
+		limit = maxLineLength - 1
+	}
+	b.WriteString(line + "\r\n")
+}
+
+func isRuneStart(c byte) bool {
+	return c&0xC0 != 0x80
+}
+
+func joinInts(ints []int) string {
+	s := make([]string, len(ints))
+	for i, n := range ints {
+		s[i] = strconv.Itoa(n)
+	}
+	return strings.Join(s, ",")
+}
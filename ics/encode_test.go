@@ -0,0 +1,117 @@
+package ics
+
+import (
+	"strings"
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarshal(t *testing.T) {
+	stockholm, err := time.LoadLocation("Europe/Stockholm")
+	assert.NoError(t, err)
+
+	start := time.Date(2024, 3, 16, 22, 0, 0, 0, stockholm)
+	event := Event{
+		UID:       "pingdom-maintenance-7",
+		Summary:   "Deploy; database, cache",
+		Start:     start,
+		End:       start.Add(2 * time.Hour),
+		Stamp:     time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		RRule:     &RRule{Freq: Weekly, Interval: 2, Until: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)},
+		UptimeIDs: []int{12345, 67890},
+	}
+
+	want := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//go-pingdom//ics//EN",
+		"BEGIN:VTIMEZONE",
+		"TZID:Europe/Stockholm",
+		"BEGIN:STANDARD",
+		"DTSTART:20240316T220000",
+		"TZOFFSETFROM:+0100",
+		"TZOFFSETTO:+0100",
+		"TZNAME:CET",
+		"END:STANDARD",
+		"BEGIN:DAYLIGHT",
+		"DTSTART:20240331T020000",
+		"TZOFFSETFROM:+0100",
+		"TZOFFSETTO:+0200",
+		"TZNAME:CEST",
+		"END:DAYLIGHT",
+		"END:VTIMEZONE",
+		"BEGIN:VEVENT",
+		"UID:pingdom-maintenance-7",
+		"DTSTAMP:20240101T000000Z",
+		"DTSTART;TZID=Europe/Stockholm:20240316T220000",
+		"DTEND;TZID=Europe/Stockholm:20240317T000000",
+		`SUMMARY:Deploy\; database\, cache`,
+		"RRULE:FREQ=WEEKLY;INTERVAL=2;UNTIL=20240601T000000Z",
+		"X-PINGDOM-UPTIMEIDS:12345,67890",
+		"END:VEVENT",
+		"END:VCALENDAR",
+		"",
+	}, "\r\n")
+
+	assert.Equal(t, want, string(Marshal(event)))
+}
+
+func TestWriteLineFolds(t *testing.T) {
+	var b strings.Builder
+	writeLine(&b, "SUMMARY:"+strings.Repeat("é", 60))
+
+	lines := strings.Split(strings.TrimSuffix(b.String(), "\r\n"), "\r\n")
+	assert.Len(t, lines, 2)
+	for _, l := range lines {
+		assert.True(t, len(l) <= maxLineLength, "line %q is too long", l)
+	}
+	assert.Equal(t, "SUMMARY:"+strings.Repeat("é", 60), lines[0]+strings.TrimPrefix(lines[1], " "))
+}
+
+func TestEncodeTimeLocal(t *testing.T) {
+	start := time.Date(2024, 3, 16, 22, 0, 0, 0, time.UTC)
+	assert.Equal(t, ":20240316T220000Z", encodeTime(start))
+	assert.Equal(t, ":20240316T220000Z", encodeTime(start.Local()))
+}
+
+func TestMarshalUTCWithoutTimezone(t *testing.T) {
+	start := time.Date(2024, 3, 16, 22, 0, 0, 0, time.UTC)
+	ics := string(Marshal(Event{UID: "1", Start: start, End: start.Add(time.Hour)}))
+	assert.NotContains(t, ics, "VTIMEZONE")
+}
+
+func TestTimezonesCoverRecurrence(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+
+	start := time.Date(2024, 1, 10, 9, 0, 0, 0, newYork)
+	zones := timezones([]Event{
+		{Start: start, End: start.Add(time.Hour), RRule: &RRule{Freq: Daily}},
+		{Start: start.AddDate(0, -1, 0), End: start.AddDate(0, -1, 0).Add(time.Hour)},
+	})
+	assert.Len(t, zones, 1)
+	assert.Equal(t, start.AddDate(0, -1, 0), zones[0].from)
+	assert.Equal(t, start.Add(recurringCoverage), zones[0].to)
+
+	got := transitions(zones[0].from, time.Date(2025, 1, 1, 0, 0, 0, 0, newYork))
+	assert.Equal(t, []time.Time{
+		time.Date(2024, 3, 10, 7, 0, 0, 0, time.UTC),
+		time.Date(2024, 11, 3, 6, 0, 0, 0, time.UTC),
+	}, utc(got))
+}
+
+func TestFormatOffset(t *testing.T) {
+	assert.Equal(t, "+0100", formatOffset(3600))
+	assert.Equal(t, "-0330", formatOffset(-3*3600-1800))
+	assert.Equal(t, "+001241", formatOffset(12*60+41))
+}
+
+func utc(times []time.Time) []time.Time {
+	for i := range times {
+		times[i] = times[i].UTC()
+	}
+	return times
+}
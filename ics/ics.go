@@ -0,0 +1,69 @@
+// Package ics converts Pingdom maintenance windows to and from iCalendar
+// (RFC 5545) events.
+//
+// Export the maintenance windows of an account as a calendar feed:
+//
+//	maintenances, err := client.Maintenances.List()
+//	var events []ics.Event
+//	for _, m := range maintenances {
+//		event, err := ics.FromMaintenance(&m, loc)
+//		...
+//		events = append(events, event)
+//	}
+//	feed := ics.Marshal(events...)
+//
+// Import the events of a calendar as maintenance windows:
+//
+//	events, err := ics.Parse(r, loc)
+//	for _, event := range events {
+//		window, err := event.MaintenanceWindow()
+//		...
+//		window.UptimeIDs = "12345,67890"
+//		_, err = client.Maintenances.Create(window)
+//	}
+package ics
+
+import (
+	"time"
+)
+
+// Frequencies of a recurrence rule that Pingdom can represent.
+const (
+	Daily   = "DAILY"
+	Weekly  = "WEEKLY"
+	Monthly = "MONTHLY"
+)
+
+// Event is an iCalendar VEVENT.
+type Event struct {
+	UID         string
+	Summary     string
+	Description string
+	// Start and End are encoded in their location: as UTC times when it is
+	// UTC, and with a TZID of the IANA name of the location otherwise.
+	// Marshal writes a VTIMEZONE for every TZID.
+	Start time.Time
+	End   time.Time
+	// Stamp is the DTSTAMP of the event.  The time of the encoding is used
+	// when it is zero.
+	Stamp time.Time
+	RRule *RRule
+	// UptimeIDs and TmsIDs are the checks of the maintenance window.  They
+	// are encoded as the X-PINGDOM-UPTIMEIDS and X-PINGDOM-TMSIDS
+	// properties.
+	UptimeIDs []int
+	TmsIDs    []int
+	// Unsupported lists the properties of a parsed event that cannot be
+	// represented by a maintenance window, such as RDATE or EXDATE.
+	Unsupported []string
+}
+
+// RRule is an iCalendar recurrence rule.
+type RRule struct {
+	Freq     string
+	Interval int
+	Until    time.Time
+	Count    int
+	// Parts holds the other parts of a parsed rule, such as BYDAY, by name.
+	Parts map[string]string
+}
@@ -0,0 +1,168 @@
+package ics
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sam-ijegs/go-pingdom/pingdom"
+)
+
+var (
+	frequencies = map[pingdom.Recurrence]string{
+		pingdom.RecurrenceDay:   Daily,
+		pingdom.RecurrenceWeek:  Weekly,
+		pingdom.RecurrenceMonth: Monthly,
+	}
+	weekdays = []string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+)
+
+// FromMaintenance returns the event of a maintenance window.  Recurring
+// windows get an RRULE, and their times are encoded in loc so that
+// calendars repeat them at the same wall clock time as Pingdom does.  UTC
+// is used when loc is nil.
+func FromMaintenance(m *pingdom.MaintenanceResponse, loc *time.Location) (Event, error) {
+	recurrence := pingdom.Recurrence(m.RecurrenceType)
+	if err := recurrence.Valid(); err != nil {
+		return Event{}, err
+	}
+
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	e := Event{
+		UID:       "pingdom-maintenance-" + strconv.Itoa(m.ID),
+		Summary:   m.Description,
+		Start:     time.Unix(m.From, 0).In(loc),
+		End:       time.Unix(m.To, 0).In(loc),
+		UptimeIDs: m.Checks.Uptime,
+		TmsIDs:    m.Checks.Tms,
+	}
+
+	if recurrence.Recurring() {
+		e.RRule = &RRule{Freq: frequencies[recurrence], Interval: m.RepeatEvery}
+		if m.EffectiveTo != 0 {
+			e.RRule.Until = time.Unix(m.EffectiveTo, 0).UTC()
+		}
+	}
+
+	return e, nil
+}
+
+// FromOccurrence returns the event of a single occurrence of a maintenance
+// window, with the given summary.  UTC is used when loc is nil.
+func FromOccurrence(o pingdom.Occurrence, summary string, loc *time.Location) Event {
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	return Event{
+		UID:     "pingdom-occurrence-" + strconv.FormatInt(o.Id, 10),
+		Summary: summary,
+		Start:   time.Unix(o.From, 0).In(loc),
+		End:     time.Unix(o.To, 0).In(loc),
+	}
+}
+
+// MaintenanceWindow returns the maintenance window of the event, ready for
+// MaintenanceService.Create.  Its description is the summary of the event.
+//
+// Pingdom only repeats windows every n-th day, week or month, until a given
+// time.  Events with another kind of recurrence, such as on several days
+// of the week or with exception dates, are rejected.  A COUNT is converted
+// to the equivalent end of the recurrence.
+func (e Event) MaintenanceWindow() (*pingdom.MaintenanceWindow, error) {
+	if e.Start.IsZero() || e.End.IsZero() {
+		return nil, fmt.Errorf("event %q: start and end are required", e.UID)
+	}
+
+	if !e.End.After(e.Start) {
+		return nil, fmt.Errorf("event %q: end must be after start", e.UID)
+	}
+
+	if len(e.Unsupported) != 0 {
+		return nil, fmt.Errorf("event %q: %s cannot be represented by Pingdom", e.UID, strings.Join(e.Unsupported, ", "))
+	}
+
+	w := &pingdom.MaintenanceWindow{
+		Description: e.Summary,
+		From:        e.Start.Unix(),
+		To:          e.End.Unix(),
+		UptimeIDs:   joinInts(e.UptimeIDs),
+		TmsIDs:      joinInts(e.TmsIDs),
+	}
+
+	if e.RRule == nil {
+		return w, nil
+	}
+
+	recurrence, effectiveTo, err := e.recurrence()
+	if err != nil {
+		return nil, fmt.Errorf("event %q: %v", e.UID, err)
+	}
+
+	w.RecurrenceType = string(recurrence)
+	if e.RRule.Interval > 1 {
+		w.RepeatEvery = e.RRule.Interval
+	}
+	if !effectiveTo.IsZero() {
+		w.EffectiveTo = effectiveTo.Unix()
+	}
+
+	return w, nil
+}
+
+// recurrence returns the Pingdom recurrence of the event and its end.
+func (e Event) recurrence() (pingdom.Recurrence, time.Time, error) {
+	r := e.RRule
+
+	var recurrence pingdom.Recurrence
+	for rec, freq := range frequencies {
+		if r.Freq == freq {
+			recurrence = rec
+		}
+	}
+	if recurrence == "" {
+		return "", time.Time{}, fmt.Errorf("RRULE frequency %s cannot be represented by Pingdom, must be DAILY, WEEKLY or MONTHLY", r.Freq)
+	}
+
+	for _, name := range sortedKeys(r.Parts) {
+		value := r.Parts[name]
+		switch {
+		case name == "BYDAY" && recurrence == pingdom.RecurrenceWeek && value == weekdays[e.Start.Weekday()]:
+		case name == "BYMONTHDAY" && recurrence == pingdom.RecurrenceMonth && value == strconv.Itoa(e.Start.Day()):
+		default:
+			return "", time.Time{}, fmt.Errorf("RRULE part %s=%s cannot be represented by Pingdom", name, value)
+		}
+	}
+
+	if r.Count != 0 && !r.Until.IsZero() {
+		return "", time.Time{}, fmt.Errorf("RRULE must not have both COUNT and UNTIL")
+	}
+
+	if r.Count == 0 {
+		return recurrence, r.Until, nil
+	}
+
+	// The end of the recurrence is the end of its last occurrence, so that
+	// it is never before the end of the first one.
+	duration := e.End.Sub(e.Start)
+	interval := r.Interval
+	if interval < 1 {
+		interval = 1
+	}
+	n := (r.Count - 1) * interval
+	switch recurrence {
+	case pingdom.RecurrenceDay:
+		return recurrence, e.Start.AddDate(0, 0, n).Add(duration), nil
+	case pingdom.RecurrenceWeek:
+		return recurrence, e.Start.AddDate(0, 0, 7*n).Add(duration), nil
+	default:
+		if e.Start.Day() > 28 {
+			return "", time.Time{}, fmt.Errorf("RRULE COUNT on a monthly recurrence after the 28th cannot be represented by Pingdom")
+		}
+		return recurrence, e.Start.AddDate(0, n, 0).Add(duration), nil
+	}
+}
@@ -0,0 +1,167 @@
+package ics
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sam-ijegs/go-pingdom/pingdom"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromMaintenance(t *testing.T) {
+	stockholm, err := time.LoadLocation("Europe/Stockholm")
+	assert.NoError(t, err)
+
+	from := time.Date(2024, 3, 16, 22, 0, 0, 0, stockholm)
+	m := pingdom.MaintenanceResponse{
+		ID:             7,
+		Description:    "Weekly deploy",
+		From:           from.Unix(),
+		To:             from.Add(2 * time.Hour).Unix(),
+		RecurrenceType: "week",
+		RepeatEvery:    2,
+		EffectiveTo:    time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC).Unix(),
+		Checks:         pingdom.MaintenanceCheckResponse{Uptime: []int{12345}, Tms: []int{9876}},
+	}
+
+	event, err := FromMaintenance(&m, stockholm)
+	assert.NoError(t, err)
+	assert.Equal(t, "pingdom-maintenance-7", event.UID)
+	assert.Equal(t, "Weekly deploy", event.Summary)
+	assert.Equal(t, from, event.Start)
+	assert.Equal(t, &RRule{Freq: Weekly, Interval: 2, Until: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)}, event.RRule)
+	assert.Equal(t, []int{12345}, event.UptimeIDs)
+	assert.Equal(t, []int{9876}, event.TmsIDs)
+
+	m.RecurrenceType = "none"
+	event, err = FromMaintenance(&m, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, event.RRule)
+	assert.Equal(t, time.UTC, event.Start.Location())
+
+	m.RecurrenceType = "year"
+	_, err = FromMaintenance(&m, nil)
+	assert.Error(t, err)
+}
+
+func TestFromOccurrence(t *testing.T) {
+	event := FromOccurrence(pingdom.Occurrence{Id: 42, MaintenanceId: 7, From: 1600000000, To: 1600003600}, "Deploy", nil)
+
+	assert.Equal(t, Event{
+		UID:     "pingdom-occurrence-42",
+		Summary: "Deploy",
+		Start:   time.Unix(1600000000, 0).UTC(),
+		End:     time.Unix(1600003600, 0).UTC(),
+	}, event)
+}
+
+func TestMaintenanceRoundTrip(t *testing.T) {
+	stockholm, err := time.LoadLocation("Europe/Stockholm")
+	assert.NoError(t, err)
+
+	from := time.Date(2024, 3, 16, 22, 0, 0, 0, stockholm)
+	m := pingdom.MaintenanceResponse{
+		ID:             7,
+		Description:    "Weekly deploy",
+		From:           from.Unix(),
+		To:             from.Add(2 * time.Hour).Unix(),
+		RecurrenceType: "week",
+		RepeatEvery:    2,
+		EffectiveTo:    time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC).Unix(),
+		Checks:         pingdom.MaintenanceCheckResponse{Uptime: []int{12345, 67890}},
+	}
+
+	event, err := FromMaintenance(&m, stockholm)
+	assert.NoError(t, err)
+
+	events, err := Parse(bytes.NewReader(Marshal(event)), nil)
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+
+	window, err := events[0].MaintenanceWindow()
+	assert.NoError(t, err)
+	assert.Equal(t, &pingdom.MaintenanceWindow{
+		Description:    "Weekly deploy",
+		From:           m.From,
+		To:             m.To,
+		RecurrenceType: "week",
+		RepeatEvery:    2,
+		EffectiveTo:    m.EffectiveTo,
+		UptimeIDs:      "12345,67890",
+	}, window)
+	assert.NoError(t, window.Valid())
+}
+
+func TestEventMaintenanceWindow(t *testing.T) {
+	start := time.Date(2024, 3, 16, 22, 0, 0, 0, time.UTC) // a Saturday
+	end := start.Add(time.Hour)
+
+	t.Run("one-off", func(t *testing.T) {
+		w, err := Event{Summary: "m", Start: start, End: end}.MaintenanceWindow()
+		assert.NoError(t, err)
+		assert.Equal(t, &pingdom.MaintenanceWindow{Description: "m", From: start.Unix(), To: end.Unix()}, w)
+	})
+
+	t.Run("count", func(t *testing.T) {
+		w, err := Event{Summary: "m", Start: start, End: end, RRule: &RRule{Freq: Daily, Interval: 2, Count: 3}}.MaintenanceWindow()
+		assert.NoError(t, err)
+		assert.Equal(t, "day", w.RecurrenceType)
+		assert.Equal(t, 2, w.RepeatEvery)
+		assert.Equal(t, start.AddDate(0, 0, 4).Add(time.Hour).Unix(), w.EffectiveTo)
+	})
+
+	t.Run("count of one", func(t *testing.T) {
+		events, err := Parse(strings.NewReader(strings.Join([]string{
+			"BEGIN:VCALENDAR",
+			"BEGIN:VEVENT",
+			"SUMMARY:m",
+			"DTSTART:20260101T220000Z",
+			"DTEND:20260101T230000Z",
+			"RRULE:FREQ=DAILY;COUNT=1",
+			"END:VEVENT",
+			"END:VCALENDAR",
+		}, "\r\n")), nil)
+		assert.NoError(t, err)
+		assert.Len(t, events, 1)
+
+		w, err := events[0].MaintenanceWindow()
+		assert.NoError(t, err)
+		assert.Equal(t, w.To, w.EffectiveTo)
+
+		schedule := pingdom.MaintenanceSchedule{
+			Description: w.Description,
+			From:        time.Unix(w.From, 0),
+			To:          time.Unix(w.To, 0),
+			Recurrence:  pingdom.Recurrence(w.RecurrenceType),
+			RepeatEvery: w.RepeatEvery,
+			EffectiveTo: time.Unix(w.EffectiveTo, 0),
+		}
+		assert.NoError(t, schedule.Valid())
+	})
+
+	t.Run("redundant byday", func(t *testing.T) {
+		_, err := Event{Start: start, End: end, RRule: &RRule{Freq: Weekly, Parts: map[string]string{"BYDAY": "SA"}}}.MaintenanceWindow()
+		assert.NoError(t, err)
+	})
+
+	rejected := map[string]Event{
+		"no end":         {Start: start},
+		"end before":     {Start: end, End: start},
+		"exdate":         {Start: start, End: end, Unsupported: []string{"EXDATE"}},
+		"hourly":         {Start: start, End: end, RRule: &RRule{Freq: "HOURLY"}},
+		"yearly":         {Start: start, End: end, RRule: &RRule{Freq: "YEARLY"}},
+		"several days":   {Start: start, End: end, RRule: &RRule{Freq: Weekly, Parts: map[string]string{"BYDAY": "SA,SU"}}},
+		"nth weekday":    {Start: start, End: end, RRule: &RRule{Freq: Monthly, Parts: map[string]string{"BYDAY": "3SA"}}},
+		"count and end":  {Start: start, End: end, RRule: &RRule{Freq: Daily, Count: 2, Until: end.AddDate(0, 0, 2)}},
+		"count after 28": {Start: start.AddDate(0, 0, 15), End: end.AddDate(0, 0, 15), RRule: &RRule{Freq: Monthly, Count: 2}},
+	}
+
+	for name, event := range rejected {
+		t.Run(name, func(t *testing.T) {
+			_, err := event.MaintenanceWindow()
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,324 @@
+package ics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// contentLine is an unfolded iCalendar content line.
+type contentLine struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// Parse returns the VEVENTs of a VCALENDAR.  Floating times and dates, which
+// have no timezone, are interpreted in loc, or in UTC when it is nil.
+func Parse(r io.Reader, loc *time.Location) ([]Event, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	lines, err := readLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []Event
+	var event *Event
+	var duration string
+	depth := 0
+	for i, l := range lines {
+		switch {
+		case l.name == "BEGIN" && l.value == "VEVENT":
+			event = &Event{}
+			duration = ""
+			depth = 0
+		case event == nil:
+			continue
+		case l.name == "BEGIN":
+			// Nested components, such as VALARM, are skipped.
+			depth++
+		case l.name == "END" && depth > 0:
+			depth--
+		case depth > 0:
+			continue
+		case l.name == "END" && l.value == "VEVENT":
+			if event.Start.IsZero() {
+				return nil, fmt.Errorf("content line %d: VEVENT without DTSTART", i+1)
+			}
+			if event.End.IsZero() && duration != "" {
+				d, err := parseDuration(duration)
+				if err != nil {
+					return nil, fmt.Errorf("content line %d: %v", i+1, err)
+				}
+				event.End = event.Start.Add(d)
+			}
+			events = append(events, *event)
+			event = nil
+		default:
+			if err := event.set(l, loc, &duration); err != nil {
+				return nil, fmt.Errorf("content line %d: %v", i+1, err)
+			}
+		}
+	}
+
+	return events, nil
+}
+
+func (e *Event) set(l contentLine, loc *time.Location, duration *string) error {
+	var err error
+	switch l.name {
+	case "UID":
+		e.UID = unescapeText(l.value)
+	case "SUMMARY":
+		e.Summary = unescapeText(l.value)
+	case "DESCRIPTION":
+		e.Description = unescapeText(l.value)
+	case "DTSTART":
+		e.Start, err = parseTime(l, loc)
+	case "DTEND":
+		e.End, err = parseTime(l, loc)
+	case "DTSTAMP":
+		e.Stamp, err = parseTime(l, loc)
+	case "DURATION":
+		*duration = l.value
+	case "RRULE":
+		e.RRule, err = parseRRule(l.value, loc)
+	case "X-PINGDOM-UPTIMEIDS":
+		e.UptimeIDs, err = parseInts(l.value)
+	case "X-PINGDOM-TMSIDS":
+		e.TmsIDs, err = parseInts(l.value)
+	case "RDATE", "EXDATE", "EXRULE":
+		e.Unsupported = append(e.Unsupported, l.name)
+	}
+	return err
+}
+
+// readLines unfolds and splits the content lines of r.
+func readLines(r io.Reader) ([]contentLine, error) {
+	var raw []string
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(raw) > 0 {
+			raw[len(raw)-1] += line[1:]
+			continue
+		}
+		if line != "" {
+			raw = append(raw, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	lines := make([]contentLine, 0, len(raw))
+	for i, line := range raw {
+		l, err := splitLine(line)
+		if err != nil {
+			return nil, fmt.Errorf("content line %d: %v", i+1, err)
+		}
+		lines = append(lines, l)
+	}
+	return lines, nil
+}
+
+// splitLine splits a content line into its name, parameters and value.
+// Colons and semicolons inside quoted parameter values are ignored.
+func splitLine(line string) (contentLine, error) {
+	quoted := false
+	colon := -1
+	for i, c := range line {
+		if c == '"' {
+			quoted = !quoted
+		} else if c == ':' && !quoted {
+			colon = i
+			break
+		}
+	}
+	if colon < 0 {
+		return contentLine{}, fmt.Errorf("invalid content line %q", line)
+	}
+
+	head := splitOutsideQuotes(line[:colon], ';')
+	l := contentLine{name: strings.ToUpper(head[0]), params: map[string]string{}, value: line[colon+1:]}
+	for _, p := range head[1:] {
+		name, value, _ := strings.Cut(p, "=")
+		l.params[strings.ToUpper(name)] = strings.Trim(value, `"`)
+	}
+	return l, nil
+}
+
+func splitOutsideQuotes(s string, sep rune) []string {
+	var parts []string
+	quoted := false
+	start := 0
+	for i, c := range s {
+		if c == '"' {
+			quoted = !quoted
+		} else if c == sep && !quoted {
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
+
+// parseTime parses a DATE-TIME or DATE value, honoring its TZID parameter.
+func parseTime(l contentLine, loc *time.Location) (time.Time, error) {
+	if tzid, ok := l.params["TZID"]; ok {
+		tz, err := time.LoadLocation(tzid)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("unknown TZID %q in %s", tzid, l.name)
+		}
+		loc = tz
+	}
+	return parseTimeValue(l.value, l.params["VALUE"] == "DATE", loc)
+}
+
+func parseTimeValue(value string, date bool, loc *time.Location) (time.Time, error) {
+	var t time.Time
+	var err error
+	switch {
+	case date || len(value) == len(dateFormat):
+		t, err = time.ParseInLocation(dateFormat, value, loc)
+	case strings.HasSuffix(value, "Z"):
+		t, err = time.Parse(utcFormat, value)
+	default:
+		t, err = time.ParseInLocation(localFormat, value, loc)
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q", value)
+	}
+	return t, nil
+}
+
+// parseDuration parses a DURATION value such as PT1H30M or P1D.  Negative
+// durations are not supported.
+func parseDuration(value string) (time.Duration, error) {
+	s := strings.TrimPrefix(value, "+")
+	if !strings.HasPrefix(s, "P") {
+		return 0, fmt.Errorf("invalid duration %q", value)
+	}
+	s = s[1:]
+
+	var d time.Duration
+	inTime := false
+	for s != "" {
+		if s[0] == 'T' {
+			inTime = true
+			s = s[1:]
+			continue
+		}
+		i := strings.IndexFunc(s, func(r rune) bool { return r < '0' || r > '9' })
+		if i <= 0 {
+			return 0, fmt.Errorf("invalid duration %q", value)
+		}
+		n, _ := strconv.Atoi(s[:i])
+		unit := time.Duration(0)
+		switch {
+		case s[i] == 'W' && !inTime:
+			unit = 7 * 24 * time.Hour
+		case s[i] == 'D' && !inTime:
+			unit = 24 * time.Hour
+		case s[i] == 'H' && inTime:
+			unit = time.Hour
+		case s[i] == 'M' && inTime:
+			unit = time.Minute
+		case s[i] == 'S' && inTime:
+			unit = time.Second
+		default:
+			return 0, fmt.Errorf("invalid duration %q", value)
+		}
+		d += time.Duration(n) * unit
+		s = s[i+1:]
+	}
+	return d, nil
+}
+
+func parseRRule(value string, loc *time.Location) (*RRule, error) {
+	r := &RRule{Interval: 1}
+	for _, part := range strings.Split(value, ";") {
+		name, v, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid RRULE part %q", part)
+		}
+		var err error
+		switch strings.ToUpper(name) {
+		case "FREQ":
+			r.Freq = strings.ToUpper(v)
+		case "INTERVAL":
+			r.Interval, err = strconv.Atoi(v)
+			if err == nil && r.Interval < 1 {
+				err = fmt.Errorf("invalid RRULE INTERVAL %q", v)
+			}
+		case "UNTIL":
+			r.Until, err = parseTimeValue(v, false, loc)
+		case "COUNT":
+			r.Count, err = strconv.Atoi(v)
+			if err == nil && r.Count < 1 {
+				err = fmt.Errorf("invalid RRULE COUNT %q", v)
+			}
+		case "WKST":
+			// The week start does not change rules without BYDAY.
+		default:
+			if r.Parts == nil {
+				r.Parts = map[string]string{}
+			}
+			r.Parts[strings.ToUpper(name)] = v
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if r.Freq == "" {
+		return nil, fmt.Errorf("RRULE %q without FREQ", value)
+	}
+	return r, nil
+}
+
+func unescapeText(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i == len(s)-1 {
+			b.WriteByte(s[i])
+			continue
+		}
+		i++
+		switch s[i] {
+		case 'n', 'N':
+			b.WriteByte('\n')
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return b.String()
+}
+
+func parseInts(value string) ([]int, error) {
+	var ints []int
+	for _, s := range strings.Split(value, ",") {
+		n, err := strconv.Atoi(strings.TrimSpace(s))
+		if err != nil {
+			return nil, fmt.Errorf("invalid ID %q", s)
+		}
+		ints = append(ints, n)
+	}
+	return ints, nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
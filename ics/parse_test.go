@@ -0,0 +1,91 @@
+package ics
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	feed := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"BEGIN:VTIMEZONE",
+		"TZID:Europe/Stockholm",
+		"END:VTIMEZONE",
+		"BEGIN:VEVENT",
+		"UID:freeze-1",
+		"DTSTART;TZID=\"Europe/Stockholm\":20240316T220000",
+		"DURATION:PT1H30M",
+		"SUMMARY:Change freeze\\, backend",
+		"DESCRIPTION:first line\\nsecond ",
+		" line",
+		"RRULE:FREQ=WEEKLY;WKST=MO;BYDAY=SA;COUNT=4",
+		"BEGIN:VALARM",
+		"DTSTART:20240101T000000Z",
+		"END:VALARM",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:freeze-2",
+		"DTSTART;VALUE=DATE:20240501",
+		"DTEND;VALUE=DATE:20240502",
+		"EXDATE:20240502T000000Z",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n")
+
+	events, err := Parse(strings.NewReader(feed), nil)
+	assert.NoError(t, err)
+	assert.Len(t, events, 2)
+
+	stockholm, _ := time.LoadLocation("Europe/Stockholm")
+	start := time.Date(2024, 3, 16, 22, 0, 0, 0, stockholm)
+	assert.Equal(t, "freeze-1", events[0].UID)
+	assert.Equal(t, "Change freeze, backend", events[0].Summary)
+	assert.Equal(t, "first line\nsecond line", events[0].Description)
+	assert.True(t, start.Equal(events[0].Start))
+	assert.True(t, start.Add(90*time.Minute).Equal(events[0].End))
+	assert.Equal(t, &RRule{Freq: Weekly, Interval: 1, Count: 4, Parts: map[string]string{"BYDAY": "SA"}}, events[0].RRule)
+
+	assert.Equal(t, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), events[1].Start)
+	assert.Equal(t, []string{"EXDATE"}, events[1].Unsupported)
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := map[string]string{
+		"missing start":   "BEGIN:VEVENT\r\nUID:x\r\nEND:VEVENT",
+		"bad time":        "BEGIN:VEVENT\r\nDTSTART:2024\r\nEND:VEVENT",
+		"unknown tzid":    "BEGIN:VEVENT\r\nDTSTART;TZID=Mars/Olympus:20240101T000000\r\nEND:VEVENT",
+		"rrule sans freq": "BEGIN:VEVENT\r\nDTSTART:20240101T000000Z\r\nRRULE:INTERVAL=2\r\nEND:VEVENT",
+		"bad duration":    "BEGIN:VEVENT\r\nDTSTART:20240101T000000Z\r\nDURATION:1H\r\nEND:VEVENT",
+		"no colon":        "BEGIN:VEVENT\r\nDTSTART\r\nEND:VEVENT",
+	}
+
+	for name, feed := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(feed), nil)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	tests := map[string]time.Duration{
+		"PT1H30M": 90 * time.Minute,
+		"P1D":     24 * time.Hour,
+		"P1W":     7 * 24 * time.Hour,
+		"P1DT2H":  26 * time.Hour,
+		"+PT15S":  15 * time.Second,
+	}
+
+	for in, want := range tests {
+		got, err := parseDuration(in)
+		assert.NoError(t, err, in)
+		assert.Equal(t, want, got, in)
+	}
+
+	_, err := parseDuration("PT1D")
+	assert.Error(t, err)
+}
@@ -0,0 +1,51 @@
+package pingdom
+
+import (
+	"context"
+)
+
+// ActionsService provides an interface to the Pingdom alerts log.
+type ActionsService struct {
+	client *Client
+}
+
+// List returns the alerts that were sent, filtered according to the given
+// options.
+func (cs *ActionsService) List(opts ListActionsOptions) ([]Alert, error) {
+	return cs.ListContext(context.Background(), opts)
+}
+
+// ListContext is like List but uses the given context for the request.
+func (cs *ActionsService) ListContext(ctx context.Context, opts ListActionsOptions) ([]Alert, error) {
+	if err := opts.Valid(); err != nil {
+		return nil, err
+	}
+
+	req, err := cs.client.NewRequestWithContext(ctx, "GET", "/actions", opts.Params())
+	if err != nil {
+		return nil, err
+	}
+
+	a := &listActionsJSONResponse{}
+	_, err = cs.client.Do(req, a)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.Actions.Alerts, err
+}
+
+// ListAll returns an iterator over all alerts matching the given options,
+// fetching them pageSize at a time.  The `Limit` and `Offset` of opts are
+// overridden by the iterator, and pageSize is capped at the API maximum of
+// 300.
+func (cs *ActionsService) ListAll(ctx context.Context, pageSize int, opts ListActionsOptions) *Iterator[Alert] {
+	if pageSize > maxActionsLimit {
+		pageSize = maxActionsLimit
+	}
+	return newIterator(ctx, pageSize, func(ctx context.Context, limit, offset int) ([]Alert, error) {
+		opts.Limit = limit
+		opts.Offset = offset
+		return cs.ListContext(ctx, opts)
+	})
+}
@@ -0,0 +1,116 @@
+package pingdom
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActionsServiceList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/actions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, "12345,67890", r.URL.Query().Get("checkids"))
+		assert.Equal(t, "sms", r.URL.Query().Get("via"))
+		fmt.Fprint(w, `{
+			"actions": {
+				"alerts": [
+					{
+						"contactname": "John Doe",
+						"contactid": 111250,
+						"checkid": 12345,
+						"time": 1294245226,
+						"via": "sms",
+						"status": "delivered",
+						"messageshort": "down",
+						"messagefull": "Check example.com is down",
+						"sentto": "46-5555555",
+						"charged": true
+					}
+				]
+			}
+		}`)
+	})
+
+	alerts, err := client.Actions.List(ListActionsOptions{
+		CheckIDs: []int{12345, 67890},
+		Via:      []string{"sms"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []Alert{
+		{
+			ContactName:  "John Doe",
+			ContactID:    111250,
+			CheckID:      12345,
+			Time:         time.Unix(1294245226, 0),
+			Via:          "sms",
+			Status:       "delivered",
+			MessageShort: "down",
+			MessageFull:  "Check example.com is down",
+			SentTo:       "46-5555555",
+			Charged:      true,
+		},
+	}, alerts)
+}
+
+func TestActionsServiceListInvalid(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.Actions.List(ListActionsOptions{Via: []string{"pigeon"}})
+	assert.Error(t, err)
+}
+
+func TestActionsServiceListAll(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/actions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, "2", r.URL.Query().Get("limit"))
+		assert.Equal(t, "delivered", r.URL.Query().Get("status"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		switch offset {
+		case 0:
+			fmt.Fprint(w, `{"actions":{"alerts":[{"checkid":1},{"checkid":2}]}}`)
+		case 2:
+			fmt.Fprint(w, `{"actions":{"alerts":[{"checkid":3}]}}`)
+		default:
+			t.Errorf("unexpected offset %d", offset)
+		}
+	})
+
+	it := client.Actions.ListAll(context.Background(), 2, ListActionsOptions{Status: []string{"delivered"}})
+	var ids []int
+	for it.Next() {
+		ids = append(ids, it.Value().CheckID)
+	}
+	assert.NoError(t, it.Err())
+	assert.Equal(t, []int{1, 2, 3}, ids)
+}
+
+func TestActionsServiceListAllCapsPageSize(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/actions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, "300", r.URL.Query().Get("limit"))
+		fmt.Fprint(w, `{"actions":{"alerts":[{"checkid":1}]}}`)
+	})
+
+	it := client.Actions.ListAll(context.Background(), 1000, ListActionsOptions{})
+	var ids []int
+	for it.Next() {
+		ids = append(ids, it.Value().CheckID)
+	}
+	assert.NoError(t, it.Err())
+	assert.Equal(t, []int{1}, ids)
+}
@@ -0,0 +1,97 @@
+package pingdom
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxActionsLimit is the maximum number of alerts returned by one request.
+const maxActionsLimit = 300
+
+var (
+	alertStatuses = []string{"sent", "delivered", "error", "not_delivered", "no_credits"}
+	alertVias     = []string{"email", "sms", "twitter", "iphone", "android"}
+)
+
+// ListActionsOptions are the parameters of a Pingdom actions (alerts log)
+// request.  Zero values are not sent.
+type ListActionsOptions struct {
+	From       time.Time
+	To         time.Time
+	CheckIDs   []int
+	ContactIDs []int
+	// Status filters on the delivery status: sent, delivered, error,
+	// not_delivered or no_credits.
+	Status []string
+	// Via filters on the channel: email, sms, twitter, iphone or android.
+	Via    []string
+	Limit  int
+	Offset int
+}
+
+// Valid determines whether a ListActionsOptions contains valid fields for the Pingdom API.
+func (o ListActionsOptions) Valid() error {
+	if o.Limit < 0 || o.Limit > maxActionsLimit {
+		return fmt.Errorf("invalid value %v for `Limit`, allowed values are 0-%d", o.Limit, maxActionsLimit)
+	}
+
+	if o.Offset < 0 {
+		return fmt.Errorf("invalid value %v for `Offset`, must not be negative", o.Offset)
+	}
+
+	for _, s := range o.Status {
+		if !stringInSlice(s, alertStatuses) {
+			return fmt.Errorf("invalid value %q for `Status`, allowed values are %s", s, strings.Join(alertStatuses, ", "))
+		}
+	}
+
+	for _, v := range o.Via {
+		if !stringInSlice(v, alertVias) {
+			return fmt.Errorf("invalid value %q for `Via`, allowed values are %s", v, strings.Join(alertVias, ", "))
+		}
+	}
+
+	return validTimeRange(o.From, o.To)
+}
+
+// Params returns the query parameters of a ListActionsOptions.
+func (o ListActionsOptions) Params() map[string]string {
+	m := timeRangeParams(o.From, o.To)
+
+	if len(o.CheckIDs) != 0 {
+		m["checkids"] = intListToCDString(o.CheckIDs)
+	}
+
+	if len(o.ContactIDs) != 0 {
+		m["contactids"] = intListToCDString(o.ContactIDs)
+	}
+
+	if len(o.Status) != 0 {
+		m["status"] = strings.Join(o.Status, ",")
+	}
+
+	if len(o.Via) != 0 {
+		m["via"] = strings.Join(o.Via, ",")
+	}
+
+	if o.Limit != 0 {
+		m["limit"] = strconv.Itoa(o.Limit)
+	}
+
+	if o.Offset != 0 {
+		m["offset"] = strconv.Itoa(o.Offset)
+	}
+
+	return m
+}
+
+func stringInSlice(s string, list []string) bool {
+	for _, l := range list {
+		if s == l {
+			return true
+		}
+	}
+	return false
+}
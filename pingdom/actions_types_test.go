@@ -0,0 +1,44 @@
+package pingdom
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListActionsOptionsValid(t *testing.T) {
+	from := time.Unix(1600000000, 0)
+
+	assert.NoError(t, ListActionsOptions{}.Valid())
+	assert.NoError(t, ListActionsOptions{Status: []string{"sent", "no_credits"}, Via: []string{"email"}, Limit: 300}.Valid())
+	assert.Error(t, ListActionsOptions{Limit: 301}.Valid())
+	assert.Error(t, ListActionsOptions{Offset: -1}.Valid())
+	assert.Error(t, ListActionsOptions{Status: []string{"lost"}}.Valid())
+	assert.Error(t, ListActionsOptions{Via: []string{"fax"}}.Valid())
+	assert.Equal(t, ErrBadPeriod, ListActionsOptions{From: from, To: from.Add(-time.Hour)}.Valid())
+}
+
+func TestListActionsOptionsParams(t *testing.T) {
+	assert.Equal(t, map[string]string{}, ListActionsOptions{}.Params())
+
+	assert.Equal(t, map[string]string{
+		"from":       "1600000000",
+		"to":         "1600003600",
+		"checkids":   "1,2",
+		"contactids": "3",
+		"status":     "sent,error",
+		"via":        "email,sms",
+		"limit":      "50",
+		"offset":     "100",
+	}, ListActionsOptions{
+		From:       time.Unix(1600000000, 0),
+		To:         time.Unix(1600003600, 0),
+		CheckIDs:   []int{1, 2},
+		ContactIDs: []int{3},
+		Status:     []string{"sent", "error"},
+		Via:        []string{"email", "sms"},
+		Limit:      50,
+		Offset:     100,
+	}.Params())
+}
@@ -0,0 +1,60 @@
+package pingdom
+
+import (
+	"context"
+	"strconv"
+)
+
+// AnalysisService provides an interface to the Pingdom root cause analysis
+// of outages.
+type AnalysisService struct {
+	client *Client
+}
+
+// List returns the root cause analyses of a check, filtered according to
+// the given options.
+func (cs *AnalysisService) List(checkID int, opts ListAnalysisOptions) ([]AnalysisResponse, error) {
+	return cs.ListContext(context.Background(), checkID, opts)
+}
+
+// ListContext is like List but uses the given context for the request.
+func (cs *AnalysisService) ListContext(ctx context.Context, checkID int, opts ListAnalysisOptions) ([]AnalysisResponse, error) {
+	if err := opts.Valid(); err != nil {
+		return nil, err
+	}
+
+	req, err := cs.client.NewRequestWithContext(ctx, "GET", "/analysis/"+strconv.Itoa(checkID), opts.Params())
+	if err != nil {
+		return nil, err
+	}
+
+	a := &listAnalysisJSONResponse{}
+	_, err = cs.client.Do(req, a)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.Analysis, err
+}
+
+// Read returns the raw result of a root cause analysis, including its task
+// tree.
+func (cs *AnalysisService) Read(checkID, analysisID int) (*AnalysisDetailsResponse, error) {
+	return cs.ReadContext(context.Background(), checkID, analysisID)
+}
+
+// ReadContext is like Read but uses the given context for the request.
+func (cs *AnalysisService) ReadContext(ctx context.Context, checkID, analysisID int) (*AnalysisDetailsResponse, error) {
+	req, err := cs.client.NewRequestWithContext(ctx, "GET", "/analysis/"+strconv.Itoa(checkID)+"/"+strconv.Itoa(analysisID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	a := &AnalysisDetailsResponse{}
+	_, err = cs.client.Do(req, a)
+	if err != nil {
+		return nil, err
+	}
+
+	return a, err
+}
@@ -0,0 +1,77 @@
+package pingdom
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnalysisServiceList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/analysis/12345", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, "10", r.URL.Query().Get("limit"))
+		fmt.Fprint(w, `{
+			"analysis": [
+				{"id": 6794, "timefirsttest": 1294244742, "timeconfirmtest": 1294244802},
+				{"id": 6795, "timefirsttest": 1294245226, "timeconfirmtest": 1294245286}
+			]
+		}`)
+	})
+
+	analysis, err := client.Analysis.List(12345, ListAnalysisOptions{Limit: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, []AnalysisResponse{
+		{ID: 6794, TimeFirstTest: 1294244742, TimeConfirmTest: 1294244802},
+		{ID: 6795, TimeFirstTest: 1294245226, TimeConfirmTest: 1294245286},
+	}, analysis)
+	assert.Equal(t, time.Unix(1294244802, 0), analysis[0].ConfirmTest())
+}
+
+func TestAnalysisServiceRead(t *testing.T) {
+	setup()
+	defer teardown()
+
+	body := `{
+		"analysisid": 6794,
+		"timefirsttest": 1294244742,
+		"timeconfirmtest": 1294244802,
+		"confirmprobe": 30,
+		"result": {"result": "DOWN", "errorcode": "DNS_ERROR", "errortext": "Non-recoverable failure in name resolution"},
+		"tasks": [
+			{
+				"taskid": 1,
+				"tasktype": "DNS",
+				"probeid": 30,
+				"target": "example.com",
+				"result": {"result": "FAILED"},
+				"tasks": [
+					{"taskid": 2, "tasktype": "PING", "probeid": 31, "target": "ns1.example.com", "result": {"result": "OK"}}
+				]
+			}
+		]
+	}`
+
+	mux.HandleFunc("/analysis/12345/6794", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, body)
+	})
+
+	analysis, err := client.Analysis.Read(12345, 6794)
+	assert.NoError(t, err)
+	assert.Equal(t, 6794, analysis.AnalysisID)
+	assert.Equal(t, 30, analysis.ConfirmProbe)
+	assert.Equal(t, "DNS_ERROR", analysis.Result.ErrorCode)
+	assert.JSONEq(t, body, string(analysis.Raw))
+
+	var walked []string
+	analysis.Walk(func(task AnalysisTask, depth int) {
+		walked = append(walked, fmt.Sprintf("%d:%s:%s", depth, task.TaskType, task.Result.Result))
+	})
+	assert.Equal(t, []string{"0:DNS:FAILED", "1:PING:OK"}, walked)
+}
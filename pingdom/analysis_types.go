@@ -0,0 +1,44 @@
+package pingdom
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// ListAnalysisOptions are the parameters of a Pingdom analysis list request.
+// Zero values are not sent.
+type ListAnalysisOptions struct {
+	Limit  int
+	Offset int
+	From   time.Time
+	To     time.Time
+}
+
+// Valid determines whether a ListAnalysisOptions contains valid fields for the Pingdom API.
+func (o ListAnalysisOptions) Valid() error {
+	if o.Limit < 0 {
+		return fmt.Errorf("invalid value %v for `Limit`, must not be negative", o.Limit)
+	}
+
+	if o.Offset < 0 {
+		return fmt.Errorf("invalid value %v for `Offset`, must not be negative", o.Offset)
+	}
+
+	return validTimeRange(o.From, o.To)
+}
+
+// Params returns the query parameters of a ListAnalysisOptions.
+func (o ListAnalysisOptions) Params() map[string]string {
+	m := timeRangeParams(o.From, o.To)
+
+	if o.Limit != 0 {
+		m["limit"] = strconv.Itoa(o.Limit)
+	}
+
+	if o.Offset != 0 {
+		m["offset"] = strconv.Itoa(o.Offset)
+	}
+
+	return m
+}
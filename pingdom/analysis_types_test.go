@@ -0,0 +1,32 @@
+package pingdom
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListAnalysisOptionsValid(t *testing.T) {
+	from := time.Unix(1600000000, 0)
+
+	assert.NoError(t, ListAnalysisOptions{}.Valid())
+	assert.Error(t, ListAnalysisOptions{Limit: -1}.Valid())
+	assert.Error(t, ListAnalysisOptions{Offset: -1}.Valid())
+	assert.Equal(t, ErrBadPeriod, ListAnalysisOptions{From: from, To: from}.Valid())
+}
+
+func TestListAnalysisOptionsParams(t *testing.T) {
+	assert.Equal(t, map[string]string{}, ListAnalysisOptions{}.Params())
+	assert.Equal(t, map[string]string{
+		"limit":  "10",
+		"offset": "20",
+		"from":   "1600000000",
+		"to":     "1600003600",
+	}, ListAnalysisOptions{
+		Limit:  10,
+		Offset: 20,
+		From:   time.Unix(1600000000, 0),
+		To:     time.Unix(1600003600, 0),
+	}.Params())
+}
@@ -3,6 +3,7 @@ package pingdom
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 )
 
 // PingdomResponse represents a general response from the Pingdom API.
@@ -11,10 +12,17 @@ type PingdomResponse struct {
 }
 
 // PingdomError represents an error response from the Pingdom API.
+// It matches the sentinel errors such as ErrNotFound with errors.Is.
 type PingdomError struct {
 	StatusCode int    `json:"statuscode"`
 	StatusDesc string `json:"statusdesc"`
 	Message    string `json:"errormessage"`
+
+	// Method and Path identify the request that failed.
+	Method string `json:"-"`
+	Path   string `json:"-"`
+	// Body is the raw response body, truncated to a reasonable length.
+	Body string `json:"-"`
 }
 
 // CheckResponse represents the JSON response for a check from the Pingdom API.
@@ -41,6 +49,7 @@ type CheckResponse struct {
 	ResponseTimeThreshold    int                 `json:"responsetime_threshold,omitempty"`
 	ProbeFilters             []string            `json:"probe_filters,omitempty"`
 	IPv6                     bool                `json:"ipv6,omitempty"`
+	CustomMessage            string              `json:"custom_message,omitempty"`
 
 	// Legacy; this is not returned by the API, we backfill the value from the
 	// Teams field.
@@ -60,6 +69,10 @@ type CheckResponseType struct {
 	HTTP *CheckResponseHTTPDetails `json:"http,omitempty"`
 	TCP  *CheckResponseTCPDetails  `json:"tcp,omitempty"`
 	DNS  *CheckResponseDNSDetails  `json:"dns,omitempty"`
+	SMTP *CheckResponseSMTPDetails `json:"smtp,omitempty"`
+	POP3 *CheckResponsePOP3Details `json:"pop3,omitempty"`
+	IMAP *CheckResponseIMAPDetails `json:"imap,omitempty"`
+	UDP  *CheckResponseUDPDetails  `json:"udp,omitempty"`
 }
 
 // CheckResponseTag is an optional tag that can be added to checks.
@@ -141,6 +154,23 @@ type SummaryPerformanceSummary struct {
 	Uptime      int `json:"uptime"`
 }
 
+// Start returns the start time of the interval.
+func (s SummaryPerformanceSummary) Start() time.Time {
+	return time.Unix(int64(s.StartTime), 0)
+}
+
+// UptimePercentage returns the uptime of the interval as a percentage of
+// the time the check was monitored, ignoring unmonitored time.  It requires
+// the request to set IncludeUptime and is zero when the check was not
+// monitored at all during the interval.
+func (s SummaryPerformanceSummary) UptimePercentage() float64 {
+	monitored := s.Uptime + s.Downtime
+	if monitored <= 0 {
+		return 0
+	}
+	return float64(s.Uptime) * 100 / float64(monitored)
+}
+
 // ResultsResponse represents the JSON response for detailed check results from the Pingdom API.
 type ResultsResponse struct {
 	ActiveProbes []int    `json:"activeprobes"`
@@ -189,6 +219,10 @@ func (c *CheckResponseType) UnmarshalJSON(b []byte) error {
 		c.HTTP = rawCheckDetails.HTTP
 		c.TCP = rawCheckDetails.TCP
 		c.DNS = rawCheckDetails.DNS
+		c.SMTP = rawCheckDetails.SMTP
+		c.POP3 = rawCheckDetails.POP3
+		c.IMAP = rawCheckDetails.IMAP
+		c.UDP = rawCheckDetails.UDP
 	}
 	return nil
 }
@@ -206,6 +240,32 @@ type CheckResponseHTTPDetails struct {
 	RequestHeaders    map[string]string `json:"requestheaders,omitempty"`
 	VerifyCertificate bool              `json:"verify_certificate,omitempty"`
 	SSLDownDaysBefore int               `json:"ssl_down_days_before,omitempty"`
+
+	// hasVerifyCertificate and hasSSLDownDaysBefore record whether the
+	// response contained the fields, as their zero values are meaningful.
+	hasVerifyCertificate bool
+	hasSSLDownDaysBefore bool
+}
+
+// UnmarshalJSON converts a byte array into a CheckResponseHTTPDetails,
+// recording which of the optional fields were present.
+func (d *CheckResponseHTTPDetails) UnmarshalJSON(b []byte) error {
+	type details CheckResponseHTTPDetails
+	var presence struct {
+		VerifyCertificate *bool `json:"verify_certificate"`
+		SSLDownDaysBefore *int  `json:"ssl_down_days_before"`
+	}
+
+	if err := json.Unmarshal(b, (*details)(d)); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(b, &presence); err != nil {
+		return err
+	}
+
+	d.hasVerifyCertificate = presence.VerifyCertificate != nil
+	d.hasSSLDownDaysBefore = presence.SSLDownDaysBefore != nil
+	return nil
 }
 
 // CheckResponseTCPDetails represents the details specific to TCP checks.
@@ -221,8 +281,41 @@ type CheckResponseDNSDetails struct {
 	NameServer string `json:"nameserver,omitempty"`
 }
 
+// CheckResponseSMTPDetails represents the details specific to SMTP checks.
+type CheckResponseSMTPDetails struct {
+	Port           int    `json:"port,omitempty"`
+	Username       string `json:"username,omitempty"`
+	Password       string `json:"password,omitempty"`
+	StringToExpect string `json:"stringtoexpect,omitempty"`
+	Encryption     bool   `json:"encryption,omitempty"`
+}
+
+// CheckResponsePOP3Details represents the details specific to POP3 checks.
+type CheckResponsePOP3Details struct {
+	Port           int    `json:"port,omitempty"`
+	StringToExpect string `json:"stringtoexpect,omitempty"`
+	Encryption     bool   `json:"encryption,omitempty"`
+}
+
+// CheckResponseIMAPDetails represents the details specific to IMAP checks.
+type CheckResponseIMAPDetails struct {
+	Port           int    `json:"port,omitempty"`
+	StringToExpect string `json:"stringtoexpect,omitempty"`
+	Encryption     bool   `json:"encryption,omitempty"`
+}
+
+// CheckResponseUDPDetails represents the details specific to UDP checks.
+type CheckResponseUDPDetails struct {
+	Port           int    `json:"port,omitempty"`
+	StringToSend   string `json:"stringtosend,omitempty"`
+	StringToExpect string `json:"stringtoexpect,omitempty"`
+}
+
 // Return string representation of the PingdomError.
 func (r *PingdomError) Error() string {
+	if r.Method != "" || r.Path != "" {
+		return fmt.Sprintf("%v %v: %d %v: %v", r.Method, r.Path, r.StatusCode, r.StatusDesc, r.Message)
+	}
 	return fmt.Sprintf("%d %v: %v", r.StatusCode, r.StatusDesc, r.Message)
 }
 
@@ -236,6 +329,248 @@ type listMaintenanceJSONResponse struct {
 	Maintenances []MaintenanceResponse `json:"maintenance"`
 }
 
+// AnalysisResponse represents a root cause analysis in a list from the Pingdom API.
+type AnalysisResponse struct {
+	ID              int   `json:"id"`
+	TimeFirstTest   int64 `json:"timefirsttest"`
+	TimeConfirmTest int64 `json:"timeconfirmtest"`
+}
+
+// FirstTest returns the time of the test that first detected the outage.
+func (a AnalysisResponse) FirstTest() time.Time {
+	return time.Unix(a.TimeFirstTest, 0)
+}
+
+// ConfirmTest returns the time of the test that confirmed the outage.
+func (a AnalysisResponse) ConfirmTest() time.Time {
+	return time.Unix(a.TimeConfirmTest, 0)
+}
+
+// AnalysisDetailsResponse represents the raw result of a root cause analysis
+// from the Pingdom API.  Pingdom does not document this format, so the
+// complete response is also kept in Raw.
+type AnalysisDetailsResponse struct {
+	AnalysisID      int             `json:"analysisid"`
+	TimeFirstTest   int64           `json:"timefirsttest"`
+	TimeConfirmTest int64           `json:"timeconfirmtest"`
+	ConfirmProbe    int             `json:"confirmprobe"`
+	Result          *AnalysisResult `json:"result,omitempty"`
+	Tasks           []AnalysisTask  `json:"tasks"`
+	Raw             json.RawMessage `json:"-"`
+}
+
+// AnalysisTask is a test run during a root cause analysis.  Tasks started
+// as a consequence of its result are listed in Tasks.
+type AnalysisTask struct {
+	TaskID   int             `json:"taskid"`
+	TaskType string          `json:"tasktype"`
+	ProbeID  int             `json:"probeid"`
+	Target   string          `json:"target,omitempty"`
+	Result   *AnalysisResult `json:"result,omitempty"`
+	Tasks    []AnalysisTask  `json:"tasks,omitempty"`
+}
+
+// AnalysisResult is the outcome of an analysis or of one of its tasks.
+type AnalysisResult struct {
+	Result           string                 `json:"result"`
+	ErrorCode        string                 `json:"errorcode,omitempty"`
+	ErrorText        string                 `json:"errortext,omitempty"`
+	ErrorParams      map[string]interface{} `json:"errorparams,omitempty"`
+	ErrorDiagnostics []interface{}          `json:"errordiagnostics,omitempty"`
+}
+
+// FirstTest returns the time of the test that first detected the outage.
+func (a AnalysisDetailsResponse) FirstTest() time.Time {
+	return time.Unix(a.TimeFirstTest, 0)
+}
+
+// ConfirmTest returns the time of the test that confirmed the outage.
+func (a AnalysisDetailsResponse) ConfirmTest() time.Time {
+	return time.Unix(a.TimeConfirmTest, 0)
+}
+
+// Walk calls fn for every task of the analysis, depth first, with the depth
+// of the task in the tree starting at 0.
+func (a AnalysisDetailsResponse) Walk(fn func(task AnalysisTask, depth int)) {
+	walkAnalysisTasks(a.Tasks, 0, fn)
+}
+
+func walkAnalysisTasks(tasks []AnalysisTask, depth int, fn func(task AnalysisTask, depth int)) {
+	for _, task := range tasks {
+		fn(task, depth)
+		walkAnalysisTasks(task.Tasks, depth+1, fn)
+	}
+}
+
+// UnmarshalJSON converts a byte array into an AnalysisDetailsResponse.
+func (a *AnalysisDetailsResponse) UnmarshalJSON(b []byte) error {
+	type analysisDetails AnalysisDetailsResponse
+	var d analysisDetails
+	if err := json.Unmarshal(b, &d); err != nil {
+		return err
+	}
+
+	*a = AnalysisDetailsResponse(d)
+	a.Raw = append(json.RawMessage(nil), b...)
+	return nil
+}
+
+type listAnalysisJSONResponse struct {
+	Analysis []AnalysisResponse `json:"analysis"`
+}
+
+// SingleTestResult represents the result of a single test from the Pingdom API.
+type SingleTestResult struct {
+	Status         string `json:"status"`
+	ResponseTime   int    `json:"responsetime"`
+	StatusDesc     string `json:"statusdesc"`
+	StatusDescLong string `json:"statusdesclong"`
+	ProbeID        int    `json:"probeid"`
+	ProbeDesc      string `json:"probedesc"`
+}
+
+// Up reports whether the tested target was up.
+func (r SingleTestResult) Up() bool {
+	return r.Status == "up"
+}
+
+// TracerouteResult represents the result of a traceroute from the Pingdom API.
+type TracerouteResult struct {
+	Result           string `json:"result"`
+	ProbeID          int    `json:"probeid"`
+	ProbeDescription string `json:"probedescription"`
+}
+
+type singleTestJSONResponse struct {
+	Result *SingleTestResult `json:"result"`
+}
+
+type tracerouteJSONResponse struct {
+	Traceroute *TracerouteResult `json:"traceroute"`
+}
+
+// CreditsResponse represents the JSON response for the account credits from the Pingdom API.
+type CreditsResponse struct {
+	CheckLimit                 int  `json:"checklimit"`
+	AvailableChecks            int  `json:"availablechecks"`
+	UsedDefault                int  `json:"useddefault"`
+	AvailableDefaultChecks     int  `json:"availabledefaultchecks"`
+	UsedTransaction            int  `json:"usedtransaction"`
+	AvailableTransactionChecks int  `json:"availabletransactionchecks"`
+	AvailableSMS               int  `json:"availablesms"`
+	AvailableSMSTests          int  `json:"availablesmstests"`
+	AutoFillSMS                bool `json:"autofillsms"`
+	AutoFillSMSAmount          int  `json:"autofillsms_amount"`
+	AutoFillSMSWhenLeft        int  `json:"autofillsms_when_left"`
+	MaxSMSOverage              int  `json:"max_sms_overage"`
+	AvailableRUMSites          int  `json:"availablerumsites"`
+	UsedRUMSites               int  `json:"usedrumsites"`
+	MaxRUMFilters              int  `json:"maxrumfilters"`
+	MaxRUMPageViews            int  `json:"maxrumpageviews"`
+}
+
+// ReferenceResponse represents the JSON response for the reference data from the Pingdom API.
+type ReferenceResponse struct {
+	Regions         []ReferenceRegion    `json:"regions"`
+	Timezones       []ReferenceTimezone  `json:"timezones"`
+	DatetimeFormats []ReferenceFormat    `json:"datetimeformats"`
+	NumberFormats   []ReferenceFormat    `json:"numberformats"`
+	Countries       []ReferenceCountry   `json:"countries"`
+	PhoneCodes      []ReferencePhoneCode `json:"phonecodes"`
+}
+
+// ReferenceRegion is a locale region, with its default formats and timezone.
+type ReferenceRegion struct {
+	ID               int    `json:"id"`
+	Description      string `json:"description"`
+	CountryID        int    `json:"countryid"`
+	DatetimeFormatID int    `json:"datetimeformatid"`
+	NumberFormatID   int    `json:"numberformatid"`
+	TimezoneID       string `json:"timezoneid"`
+}
+
+// ReferenceTimezone is a timezone known to Pingdom.
+type ReferenceTimezone struct {
+	ID          string `json:"id"`
+	Description string `json:"description"`
+}
+
+// ReferenceFormat is a date and time or number format known to Pingdom.
+type ReferenceFormat struct {
+	ID          int    `json:"id"`
+	Description string `json:"description"`
+}
+
+// ReferenceCountry is a country known to Pingdom.
+type ReferenceCountry struct {
+	ID  int    `json:"id"`
+	ISO string `json:"iso"`
+}
+
+// ReferencePhoneCode is the phone code of a country.
+type ReferencePhoneCode struct {
+	CountryID int    `json:"countryid"`
+	Name      string `json:"name"`
+	PhoneCode string `json:"phonecode"`
+}
+
+type creditsJSONResponse struct {
+	Credits *CreditsResponse `json:"credits"`
+}
+
+// Alert represents an alert sent by Pingdom, as listed by the actions endpoint.
+type Alert struct {
+	ContactName  string
+	ContactID    int
+	CheckID      int
+	Time         time.Time
+	Via          string
+	Status       string
+	MessageShort string
+	MessageFull  string
+	SentTo       string
+	Charged      bool
+}
+
+// UnmarshalJSON converts a byte array into an Alert.
+func (a *Alert) UnmarshalJSON(b []byte) error {
+	var raw struct {
+		ContactName  string `json:"contactname"`
+		ContactID    int    `json:"contactid"`
+		CheckID      int    `json:"checkid"`
+		Time         int64  `json:"time"`
+		Via          string `json:"via"`
+		Status       string `json:"status"`
+		MessageShort string `json:"messageshort"`
+		MessageFull  string `json:"messagefull"`
+		SentTo       string `json:"sentto"`
+		Charged      bool   `json:"charged"`
+	}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	*a = Alert{
+		ContactName:  raw.ContactName,
+		ContactID:    raw.ContactID,
+		CheckID:      raw.CheckID,
+		Time:         time.Unix(raw.Time, 0),
+		Via:          raw.Via,
+		Status:       raw.Status,
+		MessageShort: raw.MessageShort,
+		MessageFull:  raw.MessageFull,
+		SentTo:       raw.SentTo,
+		Charged:      raw.Charged,
+	}
+	return nil
+}
+
+type listActionsJSONResponse struct {
+	Actions struct {
+		Alerts []Alert `json:"alerts"`
+	} `json:"actions"`
+}
+
 type listProbesJSONResponse struct {
 	Probes []ProbeResponse `json:"probes"`
 }
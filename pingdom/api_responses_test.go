@@ -3,6 +3,7 @@ package pingdom
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -40,6 +41,19 @@ func TestPingdomError(t *testing.T) {
 	pe := PingdomError{StatusCode: 400, StatusDesc: "Bad Request", Message: "Missing param foo"}
 	want := "400 Bad Request: Missing param foo"
 	assert.Equal(t, want, pe.Error())
+
+	pe.Method = "PUT"
+	pe.Path = "/checks/1"
+	want = "PUT /checks/1: 400 Bad Request: Missing param foo"
+	assert.Equal(t, want, pe.Error())
+}
+
+func TestSummaryPerformanceSummary(t *testing.T) {
+	s := SummaryPerformanceSummary{StartTime: 1617235200, Uptime: 3000, Downtime: 600, Unmonitored: 1000}
+	assert.True(t, time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC).Equal(s.Start()))
+	assert.InDelta(t, 83.333, s.UptimePercentage(), 0.001)
+
+	assert.Equal(t, float64(0), SummaryPerformanceSummary{Unmonitored: 3600}.UptimePercentage())
 }
 
 func TestCheckResponseUnmarshal(t *testing.T) {
@@ -92,6 +106,33 @@ func TestDNSCheckResponseUnmarshal(t *testing.T) {
 	assert.Equal(t, "a.iana-servers.net", ck.Type.DNS.NameServer)
 }
 
+func TestMailAndUDPCheckResponseUnmarshal(t *testing.T) {
+	var ck CheckResponse
+
+	err := json.Unmarshal([]byte(`{"id": 1, "type": {"smtp": {"port": 587, "username": "user", "stringtoexpect": "220", "encryption": true}}}`), &ck)
+	assert.NoError(t, err)
+	assert.Equal(t, "smtp", ck.Type.Name)
+	assert.Equal(t, &CheckResponseSMTPDetails{Port: 587, Username: "user", StringToExpect: "220", Encryption: true}, ck.Type.SMTP)
+
+	ck = CheckResponse{}
+	err = json.Unmarshal([]byte(`{"id": 2, "type": {"pop3": {"port": 995, "stringtoexpect": "+OK", "encryption": true}}}`), &ck)
+	assert.NoError(t, err)
+	assert.Equal(t, "pop3", ck.Type.Name)
+	assert.Equal(t, &CheckResponsePOP3Details{Port: 995, StringToExpect: "+OK", Encryption: true}, ck.Type.POP3)
+
+	ck = CheckResponse{}
+	err = json.Unmarshal([]byte(`{"id": 3, "type": {"imap": {"port": 143, "stringtoexpect": "* OK"}}}`), &ck)
+	assert.NoError(t, err)
+	assert.Equal(t, "imap", ck.Type.Name)
+	assert.Equal(t, &CheckResponseIMAPDetails{Port: 143, StringToExpect: "* OK"}, ck.Type.IMAP)
+
+	ck = CheckResponse{}
+	err = json.Unmarshal([]byte(`{"id": 4, "type": {"udp": {"port": 27015, "stringtosend": "ping", "stringtoexpect": "pong"}}}`), &ck)
+	assert.NoError(t, err)
+	assert.Equal(t, "udp", ck.Type.Name)
+	assert.Equal(t, &CheckResponseUDPDetails{Port: 27015, StringToSend: "ping", StringToExpect: "pong"}, ck.Type.UDP)
+}
+
 var detailedContactJSON = `
 {
 	"contacts": [
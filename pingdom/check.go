@@ -1,8 +1,8 @@
 package pingdom
 
 import (
-	"encoding/json"
-	"io/ioutil"
+	"context"
+	"fmt"
 	"strconv"
 )
 
@@ -23,31 +23,49 @@ type Check interface {
 // This returns type CheckResponse rather than Check since the
 // Pingdom API does not return a complete representation of a check.
 func (cs *CheckService) List(params ...map[string]string) ([]CheckResponse, error) {
-	param := map[string]string{}
-	if len(params) == 1 {
-		param = params[0]
-	}
-	req, err := cs.client.NewRequest("GET", "/checks", param)
+	return cs.ListContext(context.Background(), params...)
+}
+
+// ListContext is like List but uses the given context for the request.
+func (cs *CheckService) ListContext(ctx context.Context, params ...map[string]string) ([]CheckResponse, error) {
+	param := mergeParams(params)
+	req, err := cs.client.NewRequestWithContext(ctx, "GET", "/checks", param)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := cs.client.client.Do(req)
+	m := &listChecksJSONResponse{}
+	_, err = cs.client.Do(req, m)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
 
-	if err := validateResponse(resp); err != nil {
-		return nil, err
-	}
+	return m.Checks, err
+}
 
-	bodyBytes, _ := ioutil.ReadAll(resp.Body)
-	bodyString := string(bodyBytes)
-	m := &listChecksJSONResponse{}
-	err = json.Unmarshal([]byte(bodyString), &m)
+// ListWithOptions returns a list of checks from Pingdom filtered according
+// to the given options.
+func (cs *CheckService) ListWithOptions(opts ListChecksOptions) ([]CheckResponse, error) {
+	return cs.ListWithOptionsContext(context.Background(), opts)
+}
 
-	return m.Checks, err
+// ListWithOptionsContext is like ListWithOptions but uses the given context
+// for the request.
+func (cs *CheckService) ListWithOptionsContext(ctx context.Context, opts ListChecksOptions) ([]CheckResponse, error) {
+	return cs.ListContext(ctx, opts.Params())
+}
+
+// ListAll returns an iterator over all checks, fetching them pageSize at a
+// time.  Any `limit` or `offset` in params is overridden by the iterator,
+// and pageSize is capped at the API maximum of 25000.
+func (cs *CheckService) ListAll(ctx context.Context, pageSize int, params ...map[string]string) *Iterator[CheckResponse] {
+	if pageSize > maxChecksLimit {
+		pageSize = maxChecksLimit
+	}
+	param := mergeParams(params)
+	return newIterator(ctx, pageSize, func(ctx context.Context, limit, offset int) ([]CheckResponse, error) {
+		return cs.ListContext(ctx, pageParams(param, limit, offset))
+	})
 }
 
 // Create a new check. This function will validate the given check param
@@ -56,11 +74,16 @@ func (cs *CheckService) List(params ...map[string]string) ([]CheckResponse, erro
 // Note that Pingdom does not return a full check object so in the returned
 // object you should only use the ID field.
 func (cs *CheckService) Create(check Check) (*CheckResponse, error) {
+	return cs.CreateContext(context.Background(), check)
+}
+
+// CreateContext is like Create but uses the given context for the request.
+func (cs *CheckService) CreateContext(ctx context.Context, check Check) (*CheckResponse, error) {
 	if err := check.Valid(); err != nil {
 		return nil, err
 	}
 
-	req, err := cs.client.NewRequest("POST", "/checks", check.PostParams())
+	req, err := cs.client.NewRequestWithContext(ctx, "POST", "/checks", check.PostParams())
 	if err != nil {
 		return nil, err
 	}
@@ -77,7 +100,12 @@ func (cs *CheckService) Create(check Check) (*CheckResponse, error) {
 // This returns type CheckResponse rather than Check since the
 // pingdom API does not return a complete representation of a check.
 func (cs *CheckService) Read(id int) (*CheckResponse, error) {
-	req, err := cs.client.NewRequest("GET", "/checks/"+strconv.Itoa(id)+"?include_teams=true", nil)
+	return cs.ReadContext(context.Background(), id)
+}
+
+// ReadContext is like Read but uses the given context for the request.
+func (cs *CheckService) ReadContext(ctx context.Context, id int) (*CheckResponse, error) {
+	req, err := cs.client.NewRequestWithContext(ctx, "GET", "/checks/"+strconv.Itoa(id)+"?include_teams=true", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -97,13 +125,19 @@ func (cs *CheckService) Read(id int) (*CheckResponse, error) {
 
 // Update will update the check represented by the given ID with the values
 // in the given check.  You should submit the complete list of values in
-// the given check parameter, not just those that have changed.
+// the given check parameter, not just those that have changed.  Use Patch
+// to change only a few fields.
 func (cs *CheckService) Update(id int, check Check) (*PingdomResponse, error) {
+	return cs.UpdateContext(context.Background(), id, check)
+}
+
+// UpdateContext is like Update but uses the given context for the request.
+func (cs *CheckService) UpdateContext(ctx context.Context, id int, check Check) (*PingdomResponse, error) {
 	if err := check.Valid(); err != nil {
 		return nil, err
 	}
 
-	req, err := cs.client.NewRequest("PUT", "/checks/"+strconv.Itoa(id), check.PutParams())
+	req, err := cs.client.NewRequestWithContext(ctx, "PUT", "/checks/"+strconv.Itoa(id), check.PutParams())
 	if err != nil {
 		return nil, err
 	}
@@ -118,7 +152,63 @@ func (cs *CheckService) Update(id int, check Check) (*PingdomResponse, error) {
 
 // Delete will delete the check for the given ID.
 func (cs *CheckService) Delete(id int) (*PingdomResponse, error) {
-	req, err := cs.client.NewRequest("DELETE", "/checks/"+strconv.Itoa(id), nil)
+	return cs.DeleteContext(context.Background(), id)
+}
+
+// DeleteContext is like Delete but uses the given context for the request.
+func (cs *CheckService) DeleteContext(ctx context.Context, id int) (*PingdomResponse, error) {
+	req, err := cs.client.NewRequestWithContext(ctx, "DELETE", "/checks/"+strconv.Itoa(id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &PingdomResponse{}
+	_, err = cs.client.Do(req, m)
+	if err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// BulkModify pauses, resumes or changes the resolution of several checks in
+// a single request.
+func (cs *CheckService) BulkModify(request BulkModifyRequest) (*PingdomResponse, error) {
+	return cs.BulkModifyContext(context.Background(), request)
+}
+
+// BulkModifyContext is like BulkModify but uses the given context for the request.
+func (cs *CheckService) BulkModifyContext(ctx context.Context, request BulkModifyRequest) (*PingdomResponse, error) {
+	if err := request.Valid(); err != nil {
+		return nil, err
+	}
+
+	req, err := cs.client.NewRequestWithContext(ctx, "PUT", "/checks", request.PutParams())
+	if err != nil {
+		return nil, err
+	}
+
+	m := &PingdomResponse{}
+	_, err = cs.client.Do(req, m)
+	if err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// BulkDelete will delete the checks for the given IDs in a single request.
+func (cs *CheckService) BulkDelete(ids []int) (*PingdomResponse, error) {
+	return cs.BulkDeleteContext(context.Background(), ids)
+}
+
+// BulkDeleteContext is like BulkDelete but uses the given context for the request.
+func (cs *CheckService) BulkDeleteContext(ctx context.Context, ids []int) (*PingdomResponse, error) {
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("empty id list for multiple check delete")
+	}
+
+	req, err := cs.client.NewRequestWithContext(ctx, "DELETE", "/checks", map[string]string{
+		"delcheckids": intListToCDString(ids),
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -133,11 +223,17 @@ func (cs *CheckService) Delete(id int) (*PingdomResponse, error) {
 
 // SummaryPerformance returns a performance summary from Pingdom.
 func (cs *CheckService) SummaryPerformance(request SummaryPerformanceRequest) (*SummaryPerformanceResponse, error) {
+	return cs.SummaryPerformanceContext(context.Background(), request)
+}
+
+// SummaryPerformanceContext is like SummaryPerformance but uses the given
+// context for the request.
+func (cs *CheckService) SummaryPerformanceContext(ctx context.Context, request SummaryPerformanceRequest) (*SummaryPerformanceResponse, error) {
 	if err := request.Valid(); err != nil {
 		return nil, err
 	}
 
-	req, err := cs.client.NewRequest("GET", "/summary.performance/"+strconv.Itoa(request.Id), request.GetParams())
+	req, err := cs.client.NewRequestWithContext(ctx, "GET", "/summary.performance/"+strconv.Itoa(request.Id), request.GetParams())
 	if err != nil {
 		return nil, err
 	}
@@ -150,31 +246,141 @@ func (cs *CheckService) SummaryPerformance(request SummaryPerformanceRequest) (*
 	return m, nil
 }
 
-// Results returns raw check results and the list of associated probe IDs used from Pingdom.
-func (cs *CheckService) Results(id int, params ...map[string]string) (*ResultsResponse, error) {
-	param := map[string]string{}
-	if len(params) == 1 {
-		param = params[0]
+// SummaryAverage returns the average response time and uptime of a check from Pingdom.
+func (cs *CheckService) SummaryAverage(request SummaryAverageRequest) (*SummaryAverageResponse, error) {
+	return cs.SummaryAverageContext(context.Background(), request)
+}
+
+// SummaryAverageContext is like SummaryAverage but uses the given context for the
+// request.
+func (cs *CheckService) SummaryAverageContext(ctx context.Context, request SummaryAverageRequest) (*SummaryAverageResponse, error) {
+	if err := request.Valid(); err != nil {
+		return nil, err
+	}
+
+	req, err := cs.client.NewRequestWithContext(ctx, "GET", "/summary.average/"+strconv.Itoa(request.Id), request.GetParams())
+	if err != nil {
+		return nil, err
+	}
+	m := &SummaryAverageResponse{}
+	_, err = cs.client.Do(req, m)
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// SummaryOutage returns the list of up and down intervals of a check from Pingdom.
+func (cs *CheckService) SummaryOutage(request SummaryOutageRequest) (*SummaryOutageResponse, error) {
+	return cs.SummaryOutageContext(context.Background(), request)
+}
+
+// SummaryOutageContext is like SummaryOutage but uses the given context for the
+// request.
+func (cs *CheckService) SummaryOutageContext(ctx context.Context, request SummaryOutageRequest) (*SummaryOutageResponse, error) {
+	if err := request.Valid(); err != nil {
+		return nil, err
+	}
+
+	req, err := cs.client.NewRequestWithContext(ctx, "GET", "/summary.outage/"+strconv.Itoa(request.Id), request.GetParams())
+	if err != nil {
+		return nil, err
+	}
+	m := &SummaryOutageResponse{}
+	_, err = cs.client.Do(req, m)
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// SummaryHoursOfDay returns the average response time of a check for each hour of the day from Pingdom.
+func (cs *CheckService) SummaryHoursOfDay(request SummaryHoursOfDayRequest) (*SummaryHoursOfDayResponse, error) {
+	return cs.SummaryHoursOfDayContext(context.Background(), request)
+}
+
+// SummaryHoursOfDayContext is like SummaryHoursOfDay but uses the given context for the
+// request.
+func (cs *CheckService) SummaryHoursOfDayContext(ctx context.Context, request SummaryHoursOfDayRequest) (*SummaryHoursOfDayResponse, error) {
+	if err := request.Valid(); err != nil {
+		return nil, err
 	}
-	req, err := cs.client.NewRequest("GET", "/results/"+strconv.Itoa(id), param)
+
+	req, err := cs.client.NewRequestWithContext(ctx, "GET", "/summary.hoursofday/"+strconv.Itoa(request.Id), request.GetParams())
+	if err != nil {
+		return nil, err
+	}
+	m := &SummaryHoursOfDayResponse{}
+	_, err = cs.client.Do(req, m)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := cs.client.client.Do(req)
+	return m, nil
+}
+
+// SummaryProbes returns the probes that tested a check over a period from Pingdom.
+func (cs *CheckService) SummaryProbes(request SummaryProbesRequest) (*SummaryProbesResponse, error) {
+	return cs.SummaryProbesContext(context.Background(), request)
+}
+
+// SummaryProbesContext is like SummaryProbes but uses the given context for the
+// request.
+func (cs *CheckService) SummaryProbesContext(ctx context.Context, request SummaryProbesRequest) (*SummaryProbesResponse, error) {
+	if err := request.Valid(); err != nil {
+		return nil, err
+	}
+
+	req, err := cs.client.NewRequestWithContext(ctx, "GET", "/summary.probes/"+strconv.Itoa(request.Id), request.GetParams())
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
+	m := &SummaryProbesResponse{}
+	_, err = cs.client.Do(req, m)
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Results returns raw check results and the list of associated probe IDs used from Pingdom.
+func (cs *CheckService) Results(id int, params ...map[string]string) (*ResultsResponse, error) {
+	return cs.ResultsContext(context.Background(), id, params...)
+}
 
-	if err := validateResponse(resp); err != nil {
+// ResultsContext is like Results but uses the given context for the request.
+func (cs *CheckService) ResultsContext(ctx context.Context, id int, params ...map[string]string) (*ResultsResponse, error) {
+	param := mergeParams(params)
+	req, err := cs.client.NewRequestWithContext(ctx, "GET", "/results/"+strconv.Itoa(id), param)
+	if err != nil {
 		return nil, err
 	}
 
-	bodyBytes, _ := ioutil.ReadAll(resp.Body)
-	bodyString := string(bodyBytes)
 	m := &ResultsResponse{}
-	err = json.Unmarshal([]byte(bodyString), &m)
+	_, err = cs.client.Do(req, m)
+	if err != nil {
+		return nil, err
+	}
 
 	return m, err
 }
+
+// ResultsAll returns an iterator over the raw results of a check, fetching
+// them pageSize at a time.  Any `limit` or `offset` in params is overridden
+// by the iterator, and pageSize is capped at the API maximum of 1000.
+func (cs *CheckService) ResultsAll(ctx context.Context, id int, pageSize int, params ...map[string]string) *Iterator[Result] {
+	if pageSize > maxResultsLimit {
+		pageSize = maxResultsLimit
+	}
+	param := mergeParams(params)
+	return newIterator(ctx, pageSize, func(ctx context.Context, limit, offset int) ([]Result, error) {
+		r, err := cs.ResultsContext(ctx, id, pageParams(param, limit, offset))
+		if err != nil {
+			return nil, err
+		}
+		return r.Results, nil
+	})
+}
@@ -0,0 +1,261 @@
+package pingdom
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ToCheck converts a CheckResponse into the Check of the matching type, so
+// that a check can be read, modified and submitted back with
+// CheckService.Update without losing the values that were not changed.
+//
+// The CheckResponse must hold the detailed representation of the check, as
+// returned by CheckService.Read; the list representation lacks the type
+// specific details and is rejected for all types but ping.
+func (c *CheckResponse) ToCheck() (Check, error) {
+	tags := make([]string, 0, len(c.Tags))
+	for _, tag := range c.Tags {
+		tags = append(tags, tag.Name)
+	}
+
+	teamIds := c.TeamIds
+	if len(teamIds) == 0 && len(c.Teams) != 0 {
+		teamIds = make([]int, len(c.Teams))
+		for i := range c.Teams {
+			teamIds[i] = c.Teams[i].ID
+		}
+	}
+
+	probeFilters := strings.Join(c.ProbeFilters, ",")
+	tagList := strings.Join(tags, ",")
+
+	switch c.Type.Name {
+	case "http":
+		d := c.Type.HTTP
+		if d == nil {
+			return nil, c.missingDetailsError()
+		}
+		// Options absent from the response stay nil, so that they are not
+		// sent on update.
+		var verifyCertificate *bool
+		if d.hasVerifyCertificate {
+			v := d.VerifyCertificate
+			verifyCertificate = &v
+		}
+		var sslDownDaysBefore *int
+		if d.hasSSLDownDaysBefore {
+			v := d.SSLDownDaysBefore
+			sslDownDaysBefore = &v
+		}
+		return &HttpCheck{
+			CustomMessage:            c.CustomMessage,
+			Encryption:               d.Encryption,
+			Hostname:                 c.Hostname,
+			IPV6:                     c.IPv6,
+			IntegrationIds:           c.IntegrationIds,
+			Name:                     c.Name,
+			NotifyAgainEvery:         c.NotifyAgainEvery,
+			NotifyWhenBackup:         c.NotifyWhenBackup,
+			Password:                 d.Password,
+			Paused:                   c.Paused || c.Status == "paused",
+			Port:                     d.Port,
+			PostData:                 d.PostData,
+			ProbeFilters:             probeFilters,
+			RequestHeaders:           copyHeaders(d.RequestHeaders),
+			Resolution:               c.Resolution,
+			ResponseTimeThreshold:    c.ResponseTimeThreshold,
+			SSLDownDaysBefore:        sslDownDaysBefore,
+			SendNotificationWhenDown: c.SendNotificationWhenDown,
+			ShouldContain:            d.ShouldContain,
+			ShouldNotContain:         d.ShouldNotContain,
+			Tags:                     tagList,
+			TeamIds:                  teamIds,
+			Url:                      d.Url,
+			UserIds:                  c.UserIds,
+			Username:                 d.Username,
+			VerifyCertificate:        verifyCertificate,
+		}, nil
+	case "ping":
+		return &PingCheck{
+			Hostname:                 c.Hostname,
+			IntegrationIds:           c.IntegrationIds,
+			Name:                     c.Name,
+			NotifyAgainEvery:         c.NotifyAgainEvery,
+			NotifyWhenBackup:         c.NotifyWhenBackup,
+			Paused:                   c.Paused || c.Status == "paused",
+			ProbeFilters:             probeFilters,
+			Resolution:               c.Resolution,
+			ResponseTimeThreshold:    c.ResponseTimeThreshold,
+			SendNotificationWhenDown: c.SendNotificationWhenDown,
+			Tags:                     tagList,
+			TeamIds:                  teamIds,
+			UserIds:                  c.UserIds,
+		}, nil
+	case "tcp":
+		d := c.Type.TCP
+		if d == nil {
+			return nil, c.missingDetailsError()
+		}
+		return &TCPCheck{
+			CustomMessage:            c.CustomMessage,
+			Hostname:                 c.Hostname,
+			IPV6:                     c.IPv6,
+			IntegrationIds:           c.IntegrationIds,
+			Name:                     c.Name,
+			NotifyAgainEvery:         c.NotifyAgainEvery,
+			NotifyWhenBackup:         c.NotifyWhenBackup,
+			Paused:                   c.Paused || c.Status == "paused",
+			Port:                     d.Port,
+			ProbeFilters:             probeFilters,
+			Resolution:               c.Resolution,
+			ResponseTimeThreshold:    c.ResponseTimeThreshold,
+			SendNotificationWhenDown: c.SendNotificationWhenDown,
+			StringToExpect:           d.StringToExpect,
+			StringToSend:             d.StringToSend,
+			Tags:                     tagList,
+			TeamIds:                  teamIds,
+			UserIds:                  c.UserIds,
+		}, nil
+	case "dns":
+		d := c.Type.DNS
+		if d == nil {
+			return nil, c.missingDetailsError()
+		}
+		return &DNSCheck{
+			ExpectedIP:               d.ExpectedIP,
+			Hostname:                 c.Hostname,
+			IPV6:                     c.IPv6,
+			IntegrationIds:           c.IntegrationIds,
+			Name:                     c.Name,
+			NameServer:               d.NameServer,
+			NotifyAgainEvery:         c.NotifyAgainEvery,
+			NotifyWhenBackup:         c.NotifyWhenBackup,
+			Paused:                   c.Paused || c.Status == "paused",
+			ProbeFilters:             probeFilters,
+			Resolution:               c.Resolution,
+			SendNotificationWhenDown: c.SendNotificationWhenDown,
+			Tags:                     tagList,
+			TeamIds:                  teamIds,
+			UserIds:                  c.UserIds,
+		}, nil
+	case "smtp":
+		d := c.Type.SMTP
+		if d == nil {
+			return nil, c.missingDetailsError()
+		}
+		return &SMTPCheck{
+			CustomMessage:            c.CustomMessage,
+			Encryption:               d.Encryption,
+			Hostname:                 c.Hostname,
+			IPV6:                     c.IPv6,
+			IntegrationIds:           c.IntegrationIds,
+			Name:                     c.Name,
+			NotifyAgainEvery:         c.NotifyAgainEvery,
+			NotifyWhenBackup:         c.NotifyWhenBackup,
+			Password:                 d.Password,
+			Paused:                   c.Paused || c.Status == "paused",
+			Port:                     d.Port,
+			ProbeFilters:             probeFilters,
+			Resolution:               c.Resolution,
+			ResponseTimeThreshold:    c.ResponseTimeThreshold,
+			SendNotificationWhenDown: c.SendNotificationWhenDown,
+			StringToExpect:           d.StringToExpect,
+			Tags:                     tagList,
+			TeamIds:                  teamIds,
+			UserIds:                  c.UserIds,
+			Username:                 d.Username,
+		}, nil
+	case "pop3":
+		d := c.Type.POP3
+		if d == nil {
+			return nil, c.missingDetailsError()
+		}
+		return &POP3Check{
+			CustomMessage:            c.CustomMessage,
+			Encryption:               d.Encryption,
+			Hostname:                 c.Hostname,
+			IPV6:                     c.IPv6,
+			IntegrationIds:           c.IntegrationIds,
+			Name:                     c.Name,
+			NotifyAgainEvery:         c.NotifyAgainEvery,
+			NotifyWhenBackup:         c.NotifyWhenBackup,
+			Paused:                   c.Paused || c.Status == "paused",
+			Port:                     d.Port,
+			ProbeFilters:             probeFilters,
+			Resolution:               c.Resolution,
+			ResponseTimeThreshold:    c.ResponseTimeThreshold,
+			SendNotificationWhenDown: c.SendNotificationWhenDown,
+			StringToExpect:           d.StringToExpect,
+			Tags:                     tagList,
+			TeamIds:                  teamIds,
+			UserIds:                  c.UserIds,
+		}, nil
+	case "imap":
+		d := c.Type.IMAP
+		if d == nil {
+			return nil, c.missingDetailsError()
+		}
+		return &IMAPCheck{
+			CustomMessage:            c.CustomMessage,
+			Encryption:               d.Encryption,
+			Hostname:                 c.Hostname,
+			IPV6:                     c.IPv6,
+			IntegrationIds:           c.IntegrationIds,
+			Name:                     c.Name,
+			NotifyAgainEvery:         c.NotifyAgainEvery,
+			NotifyWhenBackup:         c.NotifyWhenBackup,
+			Paused:                   c.Paused || c.Status == "paused",
+			Port:                     d.Port,
+			ProbeFilters:             probeFilters,
+			Resolution:               c.Resolution,
+			ResponseTimeThreshold:    c.ResponseTimeThreshold,
+			SendNotificationWhenDown: c.SendNotificationWhenDown,
+			StringToExpect:           d.StringToExpect,
+			Tags:                     tagList,
+			TeamIds:                  teamIds,
+			UserIds:                  c.UserIds,
+		}, nil
+	case "udp":
+		d := c.Type.UDP
+		if d == nil {
+			return nil, c.missingDetailsError()
+		}
+		return &UDPCheck{
+			CustomMessage:            c.CustomMessage,
+			Hostname:                 c.Hostname,
+			IPV6:                     c.IPv6,
+			IntegrationIds:           c.IntegrationIds,
+			Name:                     c.Name,
+			NotifyAgainEvery:         c.NotifyAgainEvery,
+			NotifyWhenBackup:         c.NotifyWhenBackup,
+			Paused:                   c.Paused || c.Status == "paused",
+			Port:                     d.Port,
+			ProbeFilters:             probeFilters,
+			Resolution:               c.Resolution,
+			ResponseTimeThreshold:    c.ResponseTimeThreshold,
+			SendNotificationWhenDown: c.SendNotificationWhenDown,
+			StringToExpect:           d.StringToExpect,
+			StringToSend:             d.StringToSend,
+			Tags:                     tagList,
+			TeamIds:                  teamIds,
+			UserIds:                  c.UserIds,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported check type %q for check %d", c.Type.Name, c.ID)
+}
+
+func (c *CheckResponse) missingDetailsError() error {
+	return fmt.Errorf("check %d has no %s details, it must be read with CheckService.Read", c.ID, c.Type.Name)
+}
+
+func copyHeaders(headers map[string]string) map[string]string {
+	if headers == nil {
+		return nil
+	}
+	m := make(map[string]string, len(headers))
+	for k, v := range headers {
+		m[k] = v
+	}
+	return m
+}
@@ -0,0 +1,186 @@
+package pingdom
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckResponseToCheckHTTP(t *testing.T) {
+	var ck CheckResponse
+	err := json.Unmarshal([]byte(`{
+		"id": 85975,
+		"name": "My check",
+		"resolution": 5,
+		"sendnotificationwhendown": 2,
+		"notifyagainevery": 10,
+		"notifywhenbackup": true,
+		"hostname": "example.com",
+		"status": "paused",
+		"integrationids": [11, 12],
+		"userids": [21],
+		"teams": [{"id": 31, "name": "ops"}],
+		"tags": [{"name": "web", "type": "u", "count": 1}, {"name": "prod", "type": "u", "count": 2}],
+		"probe_filters": ["region: EU"],
+		"responsetime_threshold": 3000,
+		"custom_message": "call me",
+		"ipv6": true,
+		"type": {
+			"http": {
+				"url": "/health",
+				"encryption": true,
+				"port": 443,
+				"username": "user",
+				"password": "pass",
+				"shouldcontain": "ok",
+				"postdata": "a=b",
+				"requestheaders": {"X-Test": "1"},
+				"verify_certificate": true,
+				"ssl_down_days_before": 7
+			}
+		}
+	}`), &ck)
+	assert.NoError(t, err)
+
+	check, err := ck.ToCheck()
+	assert.NoError(t, err)
+
+	verifyCertificate := true
+	sslDownDaysBefore := 7
+	want := &HttpCheck{
+		CustomMessage:            "call me",
+		Encryption:               true,
+		Hostname:                 "example.com",
+		IPV6:                     true,
+		IntegrationIds:           []int{11, 12},
+		Name:                     "My check",
+		NotifyAgainEvery:         10,
+		NotifyWhenBackup:         true,
+		Password:                 "pass",
+		Paused:                   true,
+		Port:                     443,
+		PostData:                 "a=b",
+		ProbeFilters:             "region: EU",
+		RequestHeaders:           map[string]string{"X-Test": "1"},
+		Resolution:               5,
+		ResponseTimeThreshold:    3000,
+		SSLDownDaysBefore:        &sslDownDaysBefore,
+		SendNotificationWhenDown: 2,
+		ShouldContain:            "ok",
+		Tags:                     "web,prod",
+		TeamIds:                  []int{31},
+		Url:                      "/health",
+		UserIds:                  []int{21},
+		Username:                 "user",
+		VerifyCertificate:        &verifyCertificate,
+	}
+	assert.Equal(t, want, check)
+	assert.NoError(t, check.Valid())
+}
+
+func TestCheckResponseToCheckTypes(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		want Check
+	}{
+		{
+			name: "ping",
+			json: `{"id": 1, "name": "ping", "hostname": "example.com", "type": "ping"}`,
+			want: &PingCheck{Name: "ping", Hostname: "example.com"},
+		},
+		{
+			name: "tcp",
+			json: `{"id": 1, "name": "tcp", "hostname": "example.com", "type": {"tcp": {"port": 22, "stringtosend": "a", "stringtoexpect": "b"}}}`,
+			want: &TCPCheck{Name: "tcp", Hostname: "example.com", Port: 22, StringToSend: "a", StringToExpect: "b"},
+		},
+		{
+			name: "dns",
+			json: detailedDNSCheckJSON,
+			want: &DNSCheck{
+				Name:                     "test-dns",
+				Hostname:                 "example.com",
+				IPV6:                     true,
+				Resolution:               1,
+				SendNotificationWhenDown: 6,
+				NotifyWhenBackup:         true,
+				ExpectedIP:               "2606:2800:220:1:248:1893:25c8:1946",
+				NameServer:               "a.iana-servers.net",
+				IntegrationIds:           []int{},
+				UserIds:                  []int{12345678},
+			},
+		},
+		{
+			name: "smtp",
+			json: `{"id": 1, "name": "smtp", "hostname": "mail.example.com", "type": {"smtp": {"port": 587, "username": "u", "encryption": true}}}`,
+			want: &SMTPCheck{Name: "smtp", Hostname: "mail.example.com", Port: 587, Username: "u", Encryption: true},
+		},
+		{
+			name: "pop3",
+			json: `{"id": 1, "name": "pop3", "hostname": "mail.example.com", "type": {"pop3": {"port": 995, "stringtoexpect": "+OK"}}}`,
+			want: &POP3Check{Name: "pop3", Hostname: "mail.example.com", Port: 995, StringToExpect: "+OK"},
+		},
+		{
+			name: "imap",
+			json: `{"id": 1, "name": "imap", "hostname": "mail.example.com", "type": {"imap": {"port": 993, "encryption": true}}}`,
+			want: &IMAPCheck{Name: "imap", Hostname: "mail.example.com", Port: 993, Encryption: true},
+		},
+		{
+			name: "udp",
+			json: `{"id": 1, "name": "udp", "hostname": "game.example.com", "type": {"udp": {"port": 27015, "stringtosend": "a", "stringtoexpect": "b"}}}`,
+			want: &UDPCheck{Name: "udp", Hostname: "game.example.com", Port: 27015, StringToSend: "a", StringToExpect: "b"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ck CheckResponse
+			assert.NoError(t, json.Unmarshal([]byte(tt.json), &ck))
+
+			check, err := ck.ToCheck()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, check)
+		})
+	}
+}
+
+func TestCheckResponseToCheckErrors(t *testing.T) {
+	ck := CheckResponse{ID: 1, Type: CheckResponseType{Name: "http"}}
+	_, err := ck.ToCheck()
+	assert.Error(t, err)
+
+	ck = CheckResponse{ID: 1, Type: CheckResponseType{Name: "transaction"}}
+	_, err = ck.ToCheck()
+	assert.Error(t, err)
+}
+
+func TestCheckResponseToCheckRoundTrip(t *testing.T) {
+	var ck CheckResponse
+	assert.NoError(t, json.Unmarshal([]byte(detailedCheckJSON), &ck))
+
+	check, err := ck.ToCheck()
+	assert.NoError(t, err)
+
+	params := check.PutParams()
+	assert.Equal(t, "s7.mydomain.com", params["host"])
+	assert.Equal(t, "/", params["url"])
+	assert.Equal(t, "80", params["port"])
+	assert.Equal(t, "2300", params["responsetime_threshold"])
+	assert.Equal(t, "Prama:no-cache", params["requestheader0"])
+}
+
+func TestCheckResponseToCheckHTTPOptionalFields(t *testing.T) {
+	var ck CheckResponse
+	err := json.Unmarshal([]byte(`{"id": 1, "name": "http", "hostname": "example.com", "type": {"http": {"url": "/"}}}`), &ck)
+	assert.NoError(t, err)
+
+	check, err := ck.ToCheck()
+	assert.NoError(t, err)
+
+	http := check.(*HttpCheck)
+	assert.Nil(t, http.VerifyCertificate)
+	assert.Nil(t, http.SSLDownDaysBefore)
+	assert.NotContains(t, http.PutParams(), "verify_certificate")
+	assert.NotContains(t, http.PutParams(), "ssl_down_days_before")
+}
@@ -7,3 +7,9 @@ var ErrMissingId = errors.New("required field 'Id' missing")
 
 // ErrBadResolution is an error for when an invalid resolution is specified.
 var ErrBadResolution = errors.New("resolution must be either 'hour', 'day' or 'week'")
+
+// ErrBadOrder is an error for when an invalid order is specified.
+var ErrBadOrder = errors.New("order must be either 'asc' or 'desc'")
+
+// ErrBadPeriod is an error for when the end of a period is not after its start.
+var ErrBadPeriod = errors.New("the end of the period must be after its start")
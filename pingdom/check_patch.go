@@ -0,0 +1,387 @@
+package pingdom
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// CheckPatch is a sparse set of changes to a check.  Only the non-nil
+// fields are applied; all other values of the check are kept as they are.
+//
+// Fields that only exist for some check types, such as Url, return an
+// error when the patched check is of another type.
+type CheckPatch struct {
+	Name                     *string
+	Hostname                 *string
+	Resolution               *int
+	Paused                   *bool
+	NotifyAgainEvery         *int
+	NotifyWhenBackup         *bool
+	SendNotificationWhenDown *int
+	ResponseTimeThreshold    *int
+	CustomMessage            *string
+	IPV6                     *bool
+	ProbeFilters             *string
+	Tags                     *[]string
+	IntegrationIds           *[]int
+	TeamIds                  *[]int
+	UserIds                  *[]int
+
+	// HTTP, SMTP, POP3 and IMAP checks.
+	Encryption *bool
+	// HTTP and SMTP checks.
+	Username *string
+	Password *string
+	// HTTP, TCP, SMTP, POP3, IMAP and UDP checks.
+	Port *int
+	// TCP, SMTP, POP3, IMAP and UDP checks.
+	StringToExpect *string
+	// TCP and UDP checks.
+	StringToSend *string
+
+	// HTTP checks.
+	Url               *string
+	ShouldContain     *string
+	ShouldNotContain  *string
+	PostData          *string
+	RequestHeaders    *map[string]string
+	VerifyCertificate *bool
+	SSLDownDaysBefore *int
+
+	// DNS checks.
+	ExpectedIP *string
+	NameServer *string
+}
+
+// Patch reads the check represented by the given ID, applies the non-nil
+// fields of the patch and submits the complete result with Update, so that
+// values not mentioned in the patch are left untouched.
+func (cs *CheckService) Patch(id int, patch CheckPatch) (*PingdomResponse, error) {
+	return cs.PatchContext(context.Background(), id, patch)
+}
+
+// PatchContext is like Patch but uses the given context for the requests.
+func (cs *CheckService) PatchContext(ctx context.Context, id int, patch CheckPatch) (*PingdomResponse, error) {
+	current, err := cs.ReadContext(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	check, err := current.ToCheck()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := patch.Apply(check); err != nil {
+		return nil, err
+	}
+
+	return cs.UpdateContext(ctx, id, check)
+}
+
+// Apply sets the non-nil fields of the patch on the given check.
+func (p CheckPatch) Apply(check Check) error {
+	f, err := fieldsOf(check)
+	if err != nil {
+		return err
+	}
+
+	var tags *string
+	if p.Tags != nil {
+		t := strings.Join(*p.Tags, ",")
+		tags = &t
+	}
+
+	errs := []error{
+		patchField("Name", f.name, p.Name),
+		patchField("Hostname", f.hostname, p.Hostname),
+		patchField("Resolution", f.resolution, p.Resolution),
+		patchField("Paused", f.paused, p.Paused),
+		patchField("NotifyAgainEvery", f.notifyAgainEvery, p.NotifyAgainEvery),
+		patchField("NotifyWhenBackup", f.notifyWhenBackup, p.NotifyWhenBackup),
+		patchField("SendNotificationWhenDown", f.sendNotificationWhenDown, p.SendNotificationWhenDown),
+		patchField("ResponseTimeThreshold", f.responseTimeThreshold, p.ResponseTimeThreshold),
+		patchField("CustomMessage", f.customMessage, p.CustomMessage),
+		patchField("IPV6", f.ipv6, p.IPV6),
+		patchField("ProbeFilters", f.probeFilters, p.ProbeFilters),
+		patchField("Tags", f.tags, tags),
+		patchField("IntegrationIds", f.integrationIds, p.IntegrationIds),
+		patchField("TeamIds", f.teamIds, p.TeamIds),
+		patchField("UserIds", f.userIds, p.UserIds),
+		patchField("Encryption", f.encryption, p.Encryption),
+		patchField("Username", f.username, p.Username),
+		patchField("Password", f.password, p.Password),
+		patchField("Port", f.port, p.Port),
+		patchField("StringToExpect", f.stringToExpect, p.StringToExpect),
+		patchField("StringToSend", f.stringToSend, p.StringToSend),
+		patchField("Url", f.url, p.Url),
+		patchField("ShouldContain", f.shouldContain, p.ShouldContain),
+		patchField("ShouldNotContain", f.shouldNotContain, p.ShouldNotContain),
+		patchField("PostData", f.postData, p.PostData),
+		patchField("RequestHeaders", f.requestHeaders, p.RequestHeaders),
+		patchOptionalField("VerifyCertificate", f.verifyCertificate, p.VerifyCertificate),
+		patchOptionalField("SSLDownDaysBefore", f.sslDownDaysBefore, p.SSLDownDaysBefore),
+		patchField("ExpectedIP", f.expectedIP, p.ExpectedIP),
+		patchField("NameServer", f.nameServer, p.NameServer),
+	}
+	for _, err := range errs {
+		if err != nil {
+			return fmt.Errorf("cannot patch %s check: %w", f.checkType, err)
+		}
+	}
+
+	return nil
+}
+
+// patchField sets *dst to *v when v is set.  A nil dst means that the check
+// type has no such field.
+func patchField[T any](name string, dst *T, v *T) error {
+	if v == nil {
+		return nil
+	}
+	if dst == nil {
+		return fmt.Errorf("field `%s` does not apply", name)
+	}
+	*dst = *v
+	return nil
+}
+
+// patchOptionalField is like patchField for check fields that are pointers
+// themselves.
+func patchOptionalField[T any](name string, dst **T, v *T) error {
+	if v == nil {
+		return nil
+	}
+	if dst == nil {
+		return fmt.Errorf("field `%s` does not apply", name)
+	}
+	value := *v
+	*dst = &value
+	return nil
+}
+
+// checkFields holds pointers to the fields of a concrete check.  Fields the
+// check type does not have are nil.
+type checkFields struct {
+	checkType string
+
+	name                     *string
+	hostname                 *string
+	resolution               *int
+	paused                   *bool
+	notifyAgainEvery         *int
+	notifyWhenBackup         *bool
+	sendNotificationWhenDown *int
+	responseTimeThreshold    *int
+	customMessage            *string
+	ipv6                     *bool
+	probeFilters             *string
+	tags                     *string
+	integrationIds           *[]int
+	teamIds                  *[]int
+	userIds                  *[]int
+
+	encryption     *bool
+	username       *string
+	password       *string
+	port           *int
+	stringToExpect *string
+	stringToSend   *string
+
+	url               *string
+	shouldContain     *string
+	shouldNotContain  *string
+	postData          *string
+	requestHeaders    *map[string]string
+	verifyCertificate **bool
+	sslDownDaysBefore **int
+
+	expectedIP *string
+	nameServer *string
+}
+
+func fieldsOf(check Check) (checkFields, error) {
+	switch ck := check.(type) {
+	case *HttpCheck:
+		return checkFields{
+			checkType:                "http",
+			name:                     &ck.Name,
+			hostname:                 &ck.Hostname,
+			resolution:               &ck.Resolution,
+			paused:                   &ck.Paused,
+			notifyAgainEvery:         &ck.NotifyAgainEvery,
+			notifyWhenBackup:         &ck.NotifyWhenBackup,
+			sendNotificationWhenDown: &ck.SendNotificationWhenDown,
+			responseTimeThreshold:    &ck.ResponseTimeThreshold,
+			customMessage:            &ck.CustomMessage,
+			ipv6:                     &ck.IPV6,
+			probeFilters:             &ck.ProbeFilters,
+			tags:                     &ck.Tags,
+			integrationIds:           &ck.IntegrationIds,
+			teamIds:                  &ck.TeamIds,
+			userIds:                  &ck.UserIds,
+			encryption:               &ck.Encryption,
+			username:                 &ck.Username,
+			password:                 &ck.Password,
+			port:                     &ck.Port,
+			url:                      &ck.Url,
+			shouldContain:            &ck.ShouldContain,
+			shouldNotContain:         &ck.ShouldNotContain,
+			postData:                 &ck.PostData,
+			requestHeaders:           &ck.RequestHeaders,
+			verifyCertificate:        &ck.VerifyCertificate,
+			sslDownDaysBefore:        &ck.SSLDownDaysBefore,
+		}, nil
+	case *PingCheck:
+		return checkFields{
+			checkType:                "ping",
+			name:                     &ck.Name,
+			hostname:                 &ck.Hostname,
+			resolution:               &ck.Resolution,
+			paused:                   &ck.Paused,
+			notifyAgainEvery:         &ck.NotifyAgainEvery,
+			notifyWhenBackup:         &ck.NotifyWhenBackup,
+			sendNotificationWhenDown: &ck.SendNotificationWhenDown,
+			responseTimeThreshold:    &ck.ResponseTimeThreshold,
+			probeFilters:             &ck.ProbeFilters,
+			tags:                     &ck.Tags,
+			integrationIds:           &ck.IntegrationIds,
+			teamIds:                  &ck.TeamIds,
+			userIds:                  &ck.UserIds,
+		}, nil
+	case *TCPCheck:
+		return checkFields{
+			checkType:                "tcp",
+			name:                     &ck.Name,
+			hostname:                 &ck.Hostname,
+			resolution:               &ck.Resolution,
+			paused:                   &ck.Paused,
+			notifyAgainEvery:         &ck.NotifyAgainEvery,
+			notifyWhenBackup:         &ck.NotifyWhenBackup,
+			sendNotificationWhenDown: &ck.SendNotificationWhenDown,
+			responseTimeThreshold:    &ck.ResponseTimeThreshold,
+			customMessage:            &ck.CustomMessage,
+			ipv6:                     &ck.IPV6,
+			probeFilters:             &ck.ProbeFilters,
+			tags:                     &ck.Tags,
+			integrationIds:           &ck.IntegrationIds,
+			teamIds:                  &ck.TeamIds,
+			userIds:                  &ck.UserIds,
+			port:                     &ck.Port,
+			stringToExpect:           &ck.StringToExpect,
+			stringToSend:             &ck.StringToSend,
+		}, nil
+	case *DNSCheck:
+		return checkFields{
+			checkType:                "dns",
+			name:                     &ck.Name,
+			hostname:                 &ck.Hostname,
+			resolution:               &ck.Resolution,
+			paused:                   &ck.Paused,
+			notifyAgainEvery:         &ck.NotifyAgainEvery,
+			notifyWhenBackup:         &ck.NotifyWhenBackup,
+			sendNotificationWhenDown: &ck.SendNotificationWhenDown,
+			ipv6:                     &ck.IPV6,
+			probeFilters:             &ck.ProbeFilters,
+			tags:                     &ck.Tags,
+			integrationIds:           &ck.IntegrationIds,
+			teamIds:                  &ck.TeamIds,
+			userIds:                  &ck.UserIds,
+			expectedIP:               &ck.ExpectedIP,
+			nameServer:               &ck.NameServer,
+		}, nil
+	case *SMTPCheck:
+		return checkFields{
+			checkType:                "smtp",
+			name:                     &ck.Name,
+			hostname:                 &ck.Hostname,
+			resolution:               &ck.Resolution,
+			paused:                   &ck.Paused,
+			notifyAgainEvery:         &ck.NotifyAgainEvery,
+			notifyWhenBackup:         &ck.NotifyWhenBackup,
+			sendNotificationWhenDown: &ck.SendNotificationWhenDown,
+			responseTimeThreshold:    &ck.ResponseTimeThreshold,
+			customMessage:            &ck.CustomMessage,
+			ipv6:                     &ck.IPV6,
+			probeFilters:             &ck.ProbeFilters,
+			tags:                     &ck.Tags,
+			integrationIds:           &ck.IntegrationIds,
+			teamIds:                  &ck.TeamIds,
+			userIds:                  &ck.UserIds,
+			encryption:               &ck.Encryption,
+			username:                 &ck.Username,
+			password:                 &ck.Password,
+			port:                     &ck.Port,
+			stringToExpect:           &ck.StringToExpect,
+		}, nil
+	case *POP3Check:
+		return checkFields{
+			checkType:                "pop3",
+			name:                     &ck.Name,
+			hostname:                 &ck.Hostname,
+			resolution:               &ck.Resolution,
+			paused:                   &ck.Paused,
+			notifyAgainEvery:         &ck.NotifyAgainEvery,
+			notifyWhenBackup:         &ck.NotifyWhenBackup,
+			sendNotificationWhenDown: &ck.SendNotificationWhenDown,
+			responseTimeThreshold:    &ck.ResponseTimeThreshold,
+			customMessage:            &ck.CustomMessage,
+			ipv6:                     &ck.IPV6,
+			probeFilters:             &ck.ProbeFilters,
+			tags:                     &ck.Tags,
+			integrationIds:           &ck.IntegrationIds,
+			teamIds:                  &ck.TeamIds,
+			userIds:                  &ck.UserIds,
+			encryption:               &ck.Encryption,
+			port:                     &ck.Port,
+			stringToExpect:           &ck.StringToExpect,
+		}, nil
+	case *IMAPCheck:
+		return checkFields{
+			checkType:                "imap",
+			name:                     &ck.Name,
+			hostname:                 &ck.Hostname,
+			resolution:               &ck.Resolution,
+			paused:                   &ck.Paused,
+			notifyAgainEvery:         &ck.NotifyAgainEvery,
+			notifyWhenBackup:         &ck.NotifyWhenBackup,
+			sendNotificationWhenDown: &ck.SendNotificationWhenDown,
+			responseTimeThreshold:    &ck.ResponseTimeThreshold,
+			customMessage:            &ck.CustomMessage,
+			ipv6:                     &ck.IPV6,
+			probeFilters:             &ck.ProbeFilters,
+			tags:                     &ck.Tags,
+			integrationIds:           &ck.IntegrationIds,
+			teamIds:                  &ck.TeamIds,
+			userIds:                  &ck.UserIds,
+			encryption:               &ck.Encryption,
+			port:                     &ck.Port,
+			stringToExpect:           &ck.StringToExpect,
+		}, nil
+	case *UDPCheck:
+		return checkFields{
+			checkType:                "udp",
+			name:                     &ck.Name,
+			hostname:                 &ck.Hostname,
+			resolution:               &ck.Resolution,
+			paused:                   &ck.Paused,
+			notifyAgainEvery:         &ck.NotifyAgainEvery,
+			notifyWhenBackup:         &ck.NotifyWhenBackup,
+			sendNotificationWhenDown: &ck.SendNotificationWhenDown,
+			responseTimeThreshold:    &ck.ResponseTimeThreshold,
+			customMessage:            &ck.CustomMessage,
+			ipv6:                     &ck.IPV6,
+			probeFilters:             &ck.ProbeFilters,
+			tags:                     &ck.Tags,
+			integrationIds:           &ck.IntegrationIds,
+			teamIds:                  &ck.TeamIds,
+			userIds:                  &ck.UserIds,
+			port:                     &ck.Port,
+			stringToExpect:           &ck.StringToExpect,
+			stringToSend:             &ck.StringToSend,
+		}, nil
+	}
+
+	return checkFields{}, fmt.Errorf("unsupported check %T", check)
+}
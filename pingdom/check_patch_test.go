@@ -0,0 +1,123 @@
+package pingdom
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckPatchApply(t *testing.T) {
+	paused := true
+	url := "/v2/health"
+	verifyCertificate := false
+	tags := []string{"a", "b"}
+
+	check := &HttpCheck{
+		Name:             "web",
+		Hostname:         "example.com",
+		Url:              "/health",
+		ShouldNotContain: "error",
+		UserIds:          []int{1},
+	}
+	patch := CheckPatch{
+		Paused:            &paused,
+		Url:               &url,
+		VerifyCertificate: &verifyCertificate,
+		Tags:              &tags,
+	}
+	assert.NoError(t, patch.Apply(check))
+
+	assert.Equal(t, "web", check.Name)
+	assert.Equal(t, "example.com", check.Hostname)
+	assert.Equal(t, "error", check.ShouldNotContain)
+	assert.Equal(t, []int{1}, check.UserIds)
+	assert.True(t, check.Paused)
+	assert.Equal(t, "/v2/health", check.Url)
+	assert.Equal(t, false, *check.VerifyCertificate)
+	assert.Equal(t, "a,b", check.Tags)
+
+	// The patch must not share memory with the check.
+	verifyCertificate = true
+	assert.Equal(t, false, *check.VerifyCertificate)
+}
+
+func TestCheckPatchApplyFieldNotApplicable(t *testing.T) {
+	url := "/health"
+	err := CheckPatch{Url: &url}.Apply(&PingCheck{Name: "ping", Hostname: "example.com"})
+	assert.EqualError(t, err, "cannot patch ping check: field `Url` does not apply")
+
+	expectedIP := "1.2.3.4"
+	err = CheckPatch{ExpectedIP: &expectedIP}.Apply(&DNSCheck{})
+	assert.NoError(t, err)
+}
+
+func TestCheckServicePatch(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/checks/85975", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"check": {
+				"id": 85975,
+				"name": "My check",
+				"hostname": "example.com",
+				"resolution": 5,
+				"status": "up",
+				"userids": [21],
+				"tags": [{"name": "prod", "type": "u", "count": 1}],
+				"type": {"http": {"url": "/health", "shouldnotcontain": "error", "port": 8080}}
+			}}`)
+		case "PUT":
+			q := r.URL.Query()
+			assert.Equal(t, "true", q.Get("paused"))
+			assert.Equal(t, "My check", q.Get("name"))
+			assert.Equal(t, "example.com", q.Get("host"))
+			assert.Equal(t, "/health", q.Get("url"))
+			assert.Equal(t, "error", q.Get("shouldnotcontain"))
+			assert.Equal(t, "8080", q.Get("port"))
+			assert.Equal(t, "21", q.Get("userids"))
+			assert.Equal(t, "prod", q.Get("tags"))
+			fmt.Fprint(w, `{"message":"Modification of check was successful!"}`)
+		default:
+			t.Errorf("unexpected method %v", r.Method)
+		}
+	})
+
+	paused := true
+	msg, err := client.Checks.Patch(85975, CheckPatch{Paused: &paused})
+	assert.NoError(t, err)
+	assert.Equal(t, &PingdomResponse{Message: "Modification of check was successful!"}, msg)
+}
+
+func TestCheckServicePatchKeepsPaused(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/checks/85975", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"check": {
+				"id": 85975,
+				"name": "My check",
+				"hostname": "example.com",
+				"resolution": 5,
+				"status": "paused",
+				"type": {"http": {"url": "/health"}}
+			}}`)
+		case "PUT":
+			q := r.URL.Query()
+			assert.Equal(t, "true", q.Get("paused"))
+			assert.Equal(t, "Renamed", q.Get("name"))
+			fmt.Fprint(w, `{"message":"Modification of check was successful!"}`)
+		default:
+			t.Errorf("unexpected method %v", r.Method)
+		}
+	})
+
+	name := "Renamed"
+	_, err := client.Checks.Patch(85975, CheckPatch{Name: &name})
+	assert.NoError(t, err)
+}
@@ -1,9 +1,12 @@
 package pingdom
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -146,6 +149,56 @@ func TestCheckServiceList(t *testing.T) {
 	assert.Equal(t, want, checks)
 }
 
+func TestCheckServiceListContext(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/checks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"checks": []}`)
+	})
+
+	checks, err := client.Checks.ListContext(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, checks)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = client.Checks.ListContext(ctx)
+	assert.True(t, errors.Is(err, context.Canceled))
+}
+
+func TestCheckServiceListMergesParams(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/checks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, "web", r.URL.Query().Get("tags"))
+		assert.Equal(t, "true", r.URL.Query().Get("include_tags"))
+		fmt.Fprint(w, `{"checks": []}`)
+	})
+
+	_, err := client.Checks.List(map[string]string{"tags": "web"}, map[string]string{"include_tags": "true"})
+	assert.NoError(t, err)
+}
+
+func TestCheckServiceListWithOptions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/checks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, "prod,web", r.URL.Query().Get("tags"))
+		assert.Equal(t, "true", r.URL.Query().Get("include_teams"))
+		fmt.Fprint(w, `{"checks": [{"id": 1, "name": "web"}]}`)
+	})
+
+	checks, err := client.Checks.ListWithOptions(ListChecksOptions{Tags: []string{"prod", "web"}, IncludeTeams: true})
+	assert.NoError(t, err)
+	assert.Equal(t, []CheckResponse{{ID: 1, Name: "web"}}, checks)
+}
+
 func TestCheckServiceCreate(t *testing.T) {
 	setup()
 	defer teardown()
@@ -299,6 +352,46 @@ func TestCheckServiceDelete(t *testing.T) {
 	assert.Equal(t, want, msg)
 }
 
+func TestCheckServiceBulkModify(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/checks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		assert.Equal(t, "12,34", r.URL.Query().Get("checkids"))
+		assert.Equal(t, "true", r.URL.Query().Get("paused"))
+		fmt.Fprint(w, `{"message":"Modification of checks was successful!"}`)
+	})
+
+	paused := true
+	want := &PingdomResponse{Message: "Modification of checks was successful!"}
+	msg, err := client.Checks.BulkModify(BulkModifyRequest{CheckIDs: []int{12, 34}, Paused: &paused})
+	assert.NoError(t, err)
+	assert.Equal(t, want, msg)
+
+	_, err = client.Checks.BulkModify(BulkModifyRequest{Paused: &paused})
+	assert.Error(t, err)
+}
+
+func TestCheckServiceBulkDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/checks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		assert.Equal(t, "12,34", r.URL.Query().Get("delcheckids"))
+		fmt.Fprint(w, `{"message":"Deletion of checks was successful!"}`)
+	})
+
+	want := &PingdomResponse{Message: "Deletion of checks was successful!"}
+	msg, err := client.Checks.BulkDelete([]int{12, 34})
+	assert.NoError(t, err)
+	assert.Equal(t, want, msg)
+
+	_, err = client.Checks.BulkDelete(nil)
+	assert.Error(t, err)
+}
+
 func TestCheckServiceSummaryPerformance(t *testing.T) {
 	id := 1337
 	t.Run("passes on error from API", func(t *testing.T) {
@@ -321,7 +414,11 @@ func TestCheckServiceSummaryPerformance(t *testing.T) {
 			StatusCode: 401,
 			StatusDesc: "Unauthorized",
 			Message:    "Invalid email and/or password",
+			Method:     "GET",
+			Path:       fmt.Sprintf("/summary.performance/%v", id),
+			Body:       errorMsg,
 		}, err)
+		assert.True(t, errors.Is(err, ErrUnauthorized))
 	})
 
 	t.Run("passes on response as datastructure", func(t *testing.T) {
@@ -458,3 +555,88 @@ func TestCheckServiceResults(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, want, results)
 }
+
+func TestCheckServiceSummaryAverage(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/summary.average/1337", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, "true", r.URL.Query().Get("byprobe"))
+		assert.Equal(t, "1600000000", r.URL.Query().Get("from"))
+		fmt.Fprint(w, `{"summary":{"responsetime":{"from":1600000000,"to":1600003600,"avgresponse":[{"probeid":28,"avgresponse":120,"n":60},{"probeid":29,"avgresponse":140,"n":58}]},"status":{"totalup":3500,"totaldown":100,"totalunknown":0}}}`)
+	})
+
+	resp, err := client.Checks.SummaryAverage(SummaryAverageRequest{
+		Id:            1337,
+		From:          time.Unix(1600000000, 0),
+		To:            time.Unix(1600003600, 0),
+		IncludeUptime: true,
+		ByProbe:       true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &SummaryAverageResponse{
+		Summary: SummaryAverage{
+			ResponseTime: SummaryAverageResponseTime{
+				From: 1600000000,
+				To:   1600003600,
+				ByProbe: []SummaryAverageProbe{
+					{ProbeID: 28, AvgResponse: 120, N: 60},
+					{ProbeID: 29, AvgResponse: 140, N: 58},
+				},
+			},
+			Status: &SummaryAverageStatus{TotalUp: 3500, TotalDown: 100},
+		},
+	}, resp)
+}
+
+func TestCheckServiceSummaryOutage(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/summary.outage/1337", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, "desc", r.URL.Query().Get("order"))
+		fmt.Fprint(w, `{"summary":{"states":[{"status":"up","timefrom":1600000000,"timeto":1600001000},{"status":"down","timefrom":1600001000,"timeto":1600001300}]}}`)
+	})
+
+	resp, err := client.Checks.SummaryOutage(SummaryOutageRequest{Id: 1337, Order: "desc"})
+	assert.NoError(t, err)
+	assert.Equal(t, []SummaryOutageState{{Status: "down", TimeFrom: 1600001000, TimeTo: 1600001300}}, resp.Summary.Outages())
+	assert.Equal(t, 5*time.Minute, resp.Summary.Outages()[0].Duration())
+}
+
+func TestCheckServiceSummaryHoursOfDay(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/summary.hoursofday/1337", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, "true", r.URL.Query().Get("uselocaltime"))
+		fmt.Fprint(w, `{"hoursofday":[{"hour":0,"avgresponse":210},{"hour":1,"avgresponse":198}]}`)
+	})
+
+	resp, err := client.Checks.SummaryHoursOfDay(SummaryHoursOfDayRequest{Id: 1337, UseLocalTime: true})
+	assert.NoError(t, err)
+	assert.Equal(t, &SummaryHoursOfDayResponse{
+		HoursOfDay: []SummaryHourOfDay{{Hour: 0, AvgResponse: 210}, {Hour: 1, AvgResponse: 198}},
+	}, resp)
+}
+
+func TestCheckServiceSummaryProbes(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/summary.probes/1337", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, "1600000000", r.URL.Query().Get("from"))
+		fmt.Fprint(w, `{"probes":[28,29,33]}`)
+	})
+
+	resp, err := client.Checks.SummaryProbes(SummaryProbesRequest{Id: 1337, From: time.Unix(1600000000, 0)})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{28, 29, 33}, resp.Probes)
+
+	_, err = client.Checks.SummaryProbes(SummaryProbesRequest{Id: 1337})
+	assert.Error(t, err)
+}
@@ -4,6 +4,17 @@ import (
 	"fmt"
 	"sort"
 	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// maxChecksLimit is the maximum number of checks returned by one request.
+	maxChecksLimit = 25000
+
+	// maxResultsLimit is the maximum number of raw check results returned by
+	// one request.
+	maxResultsLimit = 1000
 )
 
 // HttpCheck represents a Pingdom HTTP check.
@@ -94,7 +105,102 @@ type DNSCheck struct {
 	UserIds                  []int  `json:"userids,omitempty"`
 }
 
+// SMTPCheck represents a Pingdom SMTP check.
+type SMTPCheck struct {
+	CustomMessage            string `json:"custom_message,omitempty"`
+	Encryption               bool   `json:"encryption,omitempty"`
+	Hostname                 string `json:"hostname,omitempty"`
+	IPV6                     bool   `json:"ipv6,omitempty"`
+	IntegrationIds           []int  `json:"integrationids,omitempty"`
+	Name                     string `json:"name"`
+	NotifyAgainEvery         int    `json:"notifyagainevery,omitempty"`
+	NotifyWhenBackup         bool   `json:"notifywhenbackup,omitempty"`
+	Password                 string `json:"password,omitempty"`
+	Paused                   bool   `json:"paused,omitempty"`
+	Port                     int    `json:"port,omitempty"`
+	ProbeFilters             string `json:"probe_filters,omitempty"`
+	Resolution               int    `json:"resolution,omitempty"`
+	ResponseTimeThreshold    int    `json:"responsetime_threshold,omitempty"`
+	SendNotificationWhenDown int    `json:"sendnotificationwhendown,omitempty"`
+	StringToExpect           string `json:"stringtoexpect,omitempty"`
+	Tags                     string `json:"tags,omitempty"`
+	TeamIds                  []int  `json:"teamids,omitempty"`
+	UserIds                  []int  `json:"userids,omitempty"`
+	Username                 string `json:"username,omitempty"`
+}
+
+// POP3Check represents a Pingdom POP3 check.
+type POP3Check struct {
+	CustomMessage            string `json:"custom_message,omitempty"`
+	Encryption               bool   `json:"encryption,omitempty"`
+	Hostname                 string `json:"hostname,omitempty"`
+	IPV6                     bool   `json:"ipv6,omitempty"`
+	IntegrationIds           []int  `json:"integrationids,omitempty"`
+	Name                     string `json:"name"`
+	NotifyAgainEvery         int    `json:"notifyagainevery,omitempty"`
+	NotifyWhenBackup         bool   `json:"notifywhenbackup,omitempty"`
+	Paused                   bool   `json:"paused,omitempty"`
+	Port                     int    `json:"port,omitempty"`
+	ProbeFilters             string `json:"probe_filters,omitempty"`
+	Resolution               int    `json:"resolution,omitempty"`
+	ResponseTimeThreshold    int    `json:"responsetime_threshold,omitempty"`
+	SendNotificationWhenDown int    `json:"sendnotificationwhendown,omitempty"`
+	StringToExpect           string `json:"stringtoexpect,omitempty"`
+	Tags                     string `json:"tags,omitempty"`
+	TeamIds                  []int  `json:"teamids,omitempty"`
+	UserIds                  []int  `json:"userids,omitempty"`
+}
+
+// IMAPCheck represents a Pingdom IMAP check.
+type IMAPCheck struct {
+	CustomMessage            string `json:"custom_message,omitempty"`
+	Encryption               bool   `json:"encryption,omitempty"`
+	Hostname                 string `json:"hostname,omitempty"`
+	IPV6                     bool   `json:"ipv6,omitempty"`
+	IntegrationIds           []int  `json:"integrationids,omitempty"`
+	Name                     string `json:"name"`
+	NotifyAgainEvery         int    `json:"notifyagainevery,omitempty"`
+	NotifyWhenBackup         bool   `json:"notifywhenbackup,omitempty"`
+	Paused                   bool   `json:"paused,omitempty"`
+	Port                     int    `json:"port,omitempty"`
+	ProbeFilters             string `json:"probe_filters,omitempty"`
+	Resolution               int    `json:"resolution,omitempty"`
+	ResponseTimeThreshold    int    `json:"responsetime_threshold,omitempty"`
+	SendNotificationWhenDown int    `json:"sendnotificationwhendown,omitempty"`
+	StringToExpect           string `json:"stringtoexpect,omitempty"`
+	Tags                     string `json:"tags,omitempty"`
+	TeamIds                  []int  `json:"teamids,omitempty"`
+	UserIds                  []int  `json:"userids,omitempty"`
+}
+
+// UDPCheck represents a Pingdom UDP check.
+type UDPCheck struct {
+	CustomMessage            string `json:"custom_message,omitempty"`
+	Hostname                 string `json:"hostname,omitempty"`
+	IPV6                     bool   `json:"ipv6,omitempty"`
+	IntegrationIds           []int  `json:"integrationids,omitempty"`
+	Name                     string `json:"name"`
+	NotifyAgainEvery         int    `json:"notifyagainevery,omitempty"`
+	NotifyWhenBackup         bool   `json:"notifywhenbackup,omitempty"`
+	Paused                   bool   `json:"paused,omitempty"`
+	Port                     int    `json:"port"`
+	ProbeFilters             string `json:"probe_filters,omitempty"`
+	Resolution               int    `json:"resolution,omitempty"`
+	ResponseTimeThreshold    int    `json:"responsetime_threshold,omitempty"`
+	SendNotificationWhenDown int    `json:"sendnotificationwhendown,omitempty"`
+	StringToExpect           string `json:"stringtoexpect"`
+	StringToSend             string `json:"stringtosend"`
+	Tags                     string `json:"tags,omitempty"`
+	TeamIds                  []int  `json:"teamids,omitempty"`
+	UserIds                  []int  `json:"userids,omitempty"`
+}
+
 // SummaryPerformanceRequest is the API request to Pingdom for a SummaryPerformance.
+//
+// The period may be given as Unix timestamps with From and To, or as
+// time.Time values with FromTime and ToTime, which take precedence.  When
+// Period is set and no start is given, the period ends at the given end,
+// or now, and starts Period earlier.
 type SummaryPerformanceRequest struct {
 	From          int
 	Id            int
@@ -103,6 +209,39 @@ type SummaryPerformanceRequest struct {
 	Probes        string
 	Resolution    string
 	To            int
+
+	FromTime time.Time
+	ToTime   time.Time
+	Period   time.Duration
+	// ProbeIDs is the typed alternative to the comma separated Probes.
+	ProbeIDs []int
+}
+
+// BulkModifyRequest is the API request to Pingdom to pause, resume or change
+// the resolution of several checks at once.
+type BulkModifyRequest struct {
+	// CheckIDs lists the checks to modify.
+	CheckIDs []int
+	// AllChecks must be set instead of CheckIDs to modify every check of
+	// the account.
+	AllChecks bool
+	// Paused pauses or resumes the checks when set.
+	Paused *bool
+	// Resolution changes the resolution of the checks when non-zero.
+	Resolution int
+}
+
+// ListChecksOptions are the parameters of a Pingdom check list request.
+// Zero values are not sent.
+type ListChecksOptions struct {
+	Limit           int
+	Offset          int
+	ShowEncryption  bool
+	IncludeTags     bool
+	IncludeSeverity bool
+	IncludeTeams    bool
+	// Tags restricts the list to checks with any of the given tags.
+	Tags []string
 }
 
 // PutParams returns a map of parameters for an HttpCheck that can be sent along
@@ -200,7 +339,7 @@ func (ck *HttpCheck) PostParams() map[string]string {
 // Valid determines whether the HttpCheck contains valid fields.  This can be
 // used to guard against sending illegal values to the Pingdom API.
 func (ck *HttpCheck) Valid() error {
-	if err := validCommonParameters(ck.Name, ck.Hostname, ck.Resolution); err != nil {
+	if err := validCommonParameters(ck.Name, ck.Hostname, ck.Resolution, ck.ProbeFilters); err != nil {
 		return err
 	}
 
@@ -259,7 +398,7 @@ func (ck *PingCheck) PostParams() map[string]string {
 // Valid determines whether the PingCheck contains valid fields.  This can be
 // used to guard against sending illegal values to the Pingdom API.
 func (ck *PingCheck) Valid() error {
-	if err := validCommonParameters(ck.Name, ck.Hostname, ck.Resolution); err != nil {
+	if err := validCommonParameters(ck.Name, ck.Hostname, ck.Resolution, ck.ProbeFilters); err != nil {
 		return err
 	}
 
@@ -326,7 +465,7 @@ func (ck *TCPCheck) PostParams() map[string]string {
 // Valid determines whether the TCPCheck contains valid fields.  This can be
 // used to guard against sending illegal values to the Pingdom API.
 func (ck *TCPCheck) Valid() error {
-	if err := validCommonParameters(ck.Name, ck.Hostname, ck.Resolution); err != nil {
+	if err := validCommonParameters(ck.Name, ck.Hostname, ck.Resolution, ck.ProbeFilters); err != nil {
 		return err
 	}
 
@@ -385,7 +524,7 @@ func (ck *DNSCheck) PostParams() map[string]string {
 // Valid determines whether the DNSCheck contains valid fields.  This can be
 // used to guard against sending illegal values to the Pingdom API.
 func (ck *DNSCheck) Valid() error {
-	if err := validCommonParameters(ck.Name, ck.Hostname, ck.Resolution); err != nil {
+	if err := validCommonParameters(ck.Name, ck.Hostname, ck.Resolution, ck.ProbeFilters); err != nil {
 		return err
 	}
 
@@ -400,6 +539,276 @@ func (ck *DNSCheck) Valid() error {
 	return nil
 }
 
+// PutParams returns a map of parameters for an SMTPCheck that can be sent along
+// with an HTTP PUT request.
+func (ck *SMTPCheck) PutParams() map[string]string {
+	m := map[string]string{
+		"custom_message":   ck.CustomMessage,
+		"encryption":       strconv.FormatBool(ck.Encryption),
+		"host":             ck.Hostname,
+		"integrationids":   intListToCDString(ck.IntegrationIds),
+		"ipv6":             strconv.FormatBool(ck.IPV6),
+		"name":             ck.Name,
+		"notifyagainevery": strconv.Itoa(ck.NotifyAgainEvery),
+		"notifywhenbackup": strconv.FormatBool(ck.NotifyWhenBackup),
+		"paused":           strconv.FormatBool(ck.Paused),
+		"probe_filters":    ck.ProbeFilters,
+		"stringtoexpect":   ck.StringToExpect,
+		"tags":             ck.Tags,
+		"teamids":          intListToCDString(ck.TeamIds),
+		"userids":          intListToCDString(ck.UserIds),
+	}
+
+	if ck.Resolution != 0 {
+		m["resolution"] = strconv.Itoa(ck.Resolution)
+	}
+
+	if ck.ResponseTimeThreshold != 0 {
+		m["responsetime_threshold"] = strconv.Itoa(ck.ResponseTimeThreshold)
+	}
+
+	if ck.SendNotificationWhenDown != 0 {
+		m["sendnotificationwhendown"] = strconv.Itoa(ck.SendNotificationWhenDown)
+	}
+
+	if ck.Port != 0 {
+		m["port"] = strconv.Itoa(ck.Port)
+	}
+
+	// Convert auth
+	if ck.Username != "" {
+		m["auth"] = fmt.Sprintf("%s:%s", ck.Username, ck.Password)
+	}
+
+	return m
+}
+
+// PostParams returns a map of parameters for an SMTPCheck that can be sent along
+// with an HTTP POST request. Same as PUT.
+func (ck *SMTPCheck) PostParams() map[string]string {
+	params := ck.PutParams()
+
+	for k, v := range params {
+		if v == "" {
+			delete(params, k)
+		}
+	}
+
+	params["type"] = "smtp"
+	return params
+}
+
+// Valid determines whether the SMTPCheck contains valid fields.  This can be
+// used to guard against sending illegal values to the Pingdom API.
+func (ck *SMTPCheck) Valid() error {
+	if err := validCommonParameters(ck.Name, ck.Hostname, ck.Resolution, ck.ProbeFilters); err != nil {
+		return err
+	}
+
+	return validOptionalPort(ck.Port)
+}
+
+// PutParams returns a map of parameters for a POP3Check that can be sent along
+// with an HTTP PUT request.
+func (ck *POP3Check) PutParams() map[string]string {
+	m := map[string]string{
+		"custom_message":   ck.CustomMessage,
+		"encryption":       strconv.FormatBool(ck.Encryption),
+		"host":             ck.Hostname,
+		"integrationids":   intListToCDString(ck.IntegrationIds),
+		"ipv6":             strconv.FormatBool(ck.IPV6),
+		"name":             ck.Name,
+		"notifyagainevery": strconv.Itoa(ck.NotifyAgainEvery),
+		"notifywhenbackup": strconv.FormatBool(ck.NotifyWhenBackup),
+		"paused":           strconv.FormatBool(ck.Paused),
+		"probe_filters":    ck.ProbeFilters,
+		"stringtoexpect":   ck.StringToExpect,
+		"tags":             ck.Tags,
+		"teamids":          intListToCDString(ck.TeamIds),
+		"userids":          intListToCDString(ck.UserIds),
+	}
+
+	if ck.Resolution != 0 {
+		m["resolution"] = strconv.Itoa(ck.Resolution)
+	}
+
+	if ck.ResponseTimeThreshold != 0 {
+		m["responsetime_threshold"] = strconv.Itoa(ck.ResponseTimeThreshold)
+	}
+
+	if ck.SendNotificationWhenDown != 0 {
+		m["sendnotificationwhendown"] = strconv.Itoa(ck.SendNotificationWhenDown)
+	}
+
+	if ck.Port != 0 {
+		m["port"] = strconv.Itoa(ck.Port)
+	}
+
+	return m
+}
+
+// PostParams returns a map of parameters for a POP3Check that can be sent along
+// with an HTTP POST request. Same as PUT.
+func (ck *POP3Check) PostParams() map[string]string {
+	params := ck.PutParams()
+
+	for k, v := range params {
+		if v == "" {
+			delete(params, k)
+		}
+	}
+
+	params["type"] = "pop3"
+	return params
+}
+
+// Valid determines whether the POP3Check contains valid fields.  This can be
+// used to guard against sending illegal values to the Pingdom API.
+func (ck *POP3Check) Valid() error {
+	if err := validCommonParameters(ck.Name, ck.Hostname, ck.Resolution, ck.ProbeFilters); err != nil {
+		return err
+	}
+
+	return validOptionalPort(ck.Port)
+}
+
+// PutParams returns a map of parameters for an IMAPCheck that can be sent along
+// with an HTTP PUT request.
+func (ck *IMAPCheck) PutParams() map[string]string {
+	m := map[string]string{
+		"custom_message":   ck.CustomMessage,
+		"encryption":       strconv.FormatBool(ck.Encryption),
+		"host":             ck.Hostname,
+		"integrationids":   intListToCDString(ck.IntegrationIds),
+		"ipv6":             strconv.FormatBool(ck.IPV6),
+		"name":             ck.Name,
+		"notifyagainevery": strconv.Itoa(ck.NotifyAgainEvery),
+		"notifywhenbackup": strconv.FormatBool(ck.NotifyWhenBackup),
+		"paused":           strconv.FormatBool(ck.Paused),
+		"probe_filters":    ck.ProbeFilters,
+		"stringtoexpect":   ck.StringToExpect,
+		"tags":             ck.Tags,
+		"teamids":          intListToCDString(ck.TeamIds),
+		"userids":          intListToCDString(ck.UserIds),
+	}
+
+	if ck.Resolution != 0 {
+		m["resolution"] = strconv.Itoa(ck.Resolution)
+	}
+
+	if ck.ResponseTimeThreshold != 0 {
+		m["responsetime_threshold"] = strconv.Itoa(ck.ResponseTimeThreshold)
+	}
+
+	if ck.SendNotificationWhenDown != 0 {
+		m["sendnotificationwhendown"] = strconv.Itoa(ck.SendNotificationWhenDown)
+	}
+
+	if ck.Port != 0 {
+		m["port"] = strconv.Itoa(ck.Port)
+	}
+
+	return m
+}
+
+// PostParams returns a map of parameters for an IMAPCheck that can be sent along
+// with an HTTP POST request. Same as PUT.
+func (ck *IMAPCheck) PostParams() map[string]string {
+	params := ck.PutParams()
+
+	for k, v := range params {
+		if v == "" {
+			delete(params, k)
+		}
+	}
+
+	params["type"] = "imap"
+	return params
+}
+
+// Valid determines whether the IMAPCheck contains valid fields.  This can be
+// used to guard against sending illegal values to the Pingdom API.
+func (ck *IMAPCheck) Valid() error {
+	if err := validCommonParameters(ck.Name, ck.Hostname, ck.Resolution, ck.ProbeFilters); err != nil {
+		return err
+	}
+
+	return validOptionalPort(ck.Port)
+}
+
+// PutParams returns a map of parameters for a UDPCheck that can be sent along
+// with an HTTP PUT request.
+func (ck *UDPCheck) PutParams() map[string]string {
+	m := map[string]string{
+		"custom_message":   ck.CustomMessage,
+		"host":             ck.Hostname,
+		"integrationids":   intListToCDString(ck.IntegrationIds),
+		"ipv6":             strconv.FormatBool(ck.IPV6),
+		"name":             ck.Name,
+		"notifyagainevery": strconv.Itoa(ck.NotifyAgainEvery),
+		"notifywhenbackup": strconv.FormatBool(ck.NotifyWhenBackup),
+		"paused":           strconv.FormatBool(ck.Paused),
+		"port":             strconv.Itoa(ck.Port),
+		"probe_filters":    ck.ProbeFilters,
+		"stringtoexpect":   ck.StringToExpect,
+		"stringtosend":     ck.StringToSend,
+		"tags":             ck.Tags,
+		"teamids":          intListToCDString(ck.TeamIds),
+		"userids":          intListToCDString(ck.UserIds),
+	}
+
+	if ck.Resolution != 0 {
+		m["resolution"] = strconv.Itoa(ck.Resolution)
+	}
+
+	if ck.ResponseTimeThreshold != 0 {
+		m["responsetime_threshold"] = strconv.Itoa(ck.ResponseTimeThreshold)
+	}
+
+	if ck.SendNotificationWhenDown != 0 {
+		m["sendnotificationwhendown"] = strconv.Itoa(ck.SendNotificationWhenDown)
+	}
+
+	return m
+}
+
+// PostParams returns a map of parameters for a UDPCheck that can be sent along
+// with an HTTP POST request. Same as PUT.
+func (ck *UDPCheck) PostParams() map[string]string {
+	params := ck.PutParams()
+
+	for k, v := range params {
+		if v == "" {
+			delete(params, k)
+		}
+	}
+
+	params["type"] = "udp"
+	return params
+}
+
+// Valid determines whether the UDPCheck contains valid fields.  This can be
+// used to guard against sending illegal values to the Pingdom API.
+func (ck *UDPCheck) Valid() error {
+	if err := validCommonParameters(ck.Name, ck.Hostname, ck.Resolution, ck.ProbeFilters); err != nil {
+		return err
+	}
+
+	if ck.Port < 1 || ck.Port > 65535 {
+		return fmt.Errorf("Invalid value for `Port`.  Must contain an integer >= 1 and <= 65535")
+	}
+
+	if ck.StringToSend == "" {
+		return fmt.Errorf("Invalid value for `StringToSend`.  Must contain non-empty string")
+	}
+
+	if ck.StringToExpect == "" {
+		return fmt.Errorf("Invalid value for `StringToExpect`.  Must contain non-empty string")
+	}
+
+	return nil
+}
+
 func intListToCDString(integers []int) string {
 	var CDString string
 	for i, item := range integers {
@@ -412,7 +821,7 @@ func intListToCDString(integers []int) string {
 	return CDString
 }
 
-func validCommonParameters(name string, hostname string, resolution int) error {
+func validCommonParameters(name string, hostname string, resolution int, probeFilters string) error {
 	if name == "" {
 		return fmt.Errorf("invalid value for `Name`, must contain non-empty string")
 	}
@@ -421,6 +830,14 @@ func validCommonParameters(name string, hostname string, resolution int) error {
 		return fmt.Errorf("invalid value for `Hostname`, must contain non-empty string")
 	}
 
+	if err := validProbeFilters(probeFilters); err != nil {
+		return err
+	}
+
+	return validResolution(resolution)
+}
+
+func validResolution(resolution int) error {
 	// if resolution value is 0, it will be set to default value which is 5.
 	if resolution != 0 && resolution != 1 && resolution != 5 && resolution != 15 &&
 		resolution != 30 && resolution != 60 {
@@ -430,6 +847,16 @@ func validCommonParameters(name string, hostname string, resolution int) error {
 	return nil
 }
 
+// validOptionalPort checks a port that may be left to zero so that Pingdom
+// uses the default port of the protocol.
+func validOptionalPort(port int) error {
+	if port < 0 || port > 65535 {
+		return fmt.Errorf("Invalid value for `Port`.  Must contain an integer >= 0 and <= 65535")
+	}
+
+	return nil
+}
+
 // Valid determines whether a SummaryPerformanceRequest contains valid fields for the Pingdom API.
 func (csr SummaryPerformanceRequest) Valid() error {
 	if csr.Id == 0 {
@@ -439,6 +866,26 @@ func (csr SummaryPerformanceRequest) Valid() error {
 	if csr.Resolution != "" && csr.Resolution != "hour" && csr.Resolution != "day" && csr.Resolution != "week" {
 		return ErrBadResolution
 	}
+
+	if csr.Order != "" && csr.Order != "asc" && csr.Order != "desc" {
+		return ErrBadOrder
+	}
+
+	if csr.Probes != "" && len(csr.ProbeIDs) != 0 {
+		return fmt.Errorf("`Probes` and `ProbeIDs` must not be declared at the same time")
+	}
+
+	if csr.Period < 0 {
+		return fmt.Errorf("invalid value %v for `Period`, must not be negative", csr.Period)
+	}
+
+	// A Period ending at To or now always yields a valid window, so only
+	// explicit bounds are checked and the clock is read once, by GetParams.
+	from, to := csr.bounds()
+	if from != 0 && to != 0 && to <= from {
+		return ErrBadPeriod
+	}
+
 	return nil
 }
 
@@ -454,5 +901,130 @@ func (csr SummaryPerformanceRequest) GetParams() (params map[string]string) {
 		params["includeuptime"] = "true"
 	}
 
+	from, to := csr.period(time.Now())
+	if from != 0 {
+		params["from"] = strconv.FormatInt(from, 10)
+	}
+
+	if to != 0 {
+		params["to"] = strconv.FormatInt(to, 10)
+	}
+
+	if csr.Order != "" {
+		params["order"] = csr.Order
+	}
+
+	if csr.Probes != "" {
+		params["probes"] = csr.Probes
+	} else if len(csr.ProbeIDs) != 0 {
+		params["probes"] = intListToCDString(csr.ProbeIDs)
+	}
+
 	return
 }
+
+// bounds returns the explicitly requested start and end as Unix
+// timestamps, zero meaning that the bound is not set.
+func (csr SummaryPerformanceRequest) bounds() (from int64, to int64) {
+	from = int64(csr.From)
+	if !csr.FromTime.IsZero() {
+		from = csr.FromTime.Unix()
+	}
+
+	to = int64(csr.To)
+	if !csr.ToTime.IsZero() {
+		to = csr.ToTime.Unix()
+	}
+
+	return from, to
+}
+
+// period resolves the requested period into Unix timestamps, zero meaning
+// that the bound is left to the Pingdom default.
+func (csr SummaryPerformanceRequest) period(now time.Time) (from int64, to int64) {
+	from, to = csr.bounds()
+
+	if csr.Period > 0 && from == 0 {
+		end := now
+		if to != 0 {
+			end = time.Unix(to, 0)
+		}
+		from = end.Add(-csr.Period).Unix()
+		to = end.Unix()
+	}
+
+	return from, to
+}
+
+// Params returns the query parameters of a ListChecksOptions.  The result
+// may also be passed to CheckService.ListAll.
+func (o ListChecksOptions) Params() map[string]string {
+	m := map[string]string{}
+
+	if o.Limit != 0 {
+		m["limit"] = strconv.Itoa(o.Limit)
+	}
+
+	if o.Offset != 0 {
+		m["offset"] = strconv.Itoa(o.Offset)
+	}
+
+	if o.ShowEncryption {
+		m["showencryption"] = "true"
+	}
+
+	if o.IncludeTags {
+		m["include_tags"] = "true"
+	}
+
+	if o.IncludeSeverity {
+		m["include_severity"] = "true"
+	}
+
+	if o.IncludeTeams {
+		m["include_teams"] = "true"
+	}
+
+	if len(o.Tags) != 0 {
+		m["tags"] = strings.Join(o.Tags, ",")
+	}
+
+	return m
+}
+
+// Valid determines whether a BulkModifyRequest contains valid fields for the Pingdom API.
+func (r BulkModifyRequest) Valid() error {
+	if len(r.CheckIDs) == 0 && !r.AllChecks {
+		return fmt.Errorf("invalid value for `CheckIDs`, must contain at least one ID unless `AllChecks` is set")
+	}
+
+	if len(r.CheckIDs) != 0 && r.AllChecks {
+		return fmt.Errorf("`CheckIDs` and `AllChecks` must not be declared at the same time")
+	}
+
+	if r.Paused == nil && r.Resolution == 0 {
+		return fmt.Errorf("nothing to modify, `Paused` or `Resolution` must be set")
+	}
+
+	return validResolution(r.Resolution)
+}
+
+// PutParams returns a map of parameters for a BulkModifyRequest that can be
+// sent along with an HTTP PUT request.
+func (r BulkModifyRequest) PutParams() map[string]string {
+	m := map[string]string{}
+
+	if len(r.CheckIDs) != 0 {
+		m["checkids"] = intListToCDString(r.CheckIDs)
+	}
+
+	if r.Paused != nil {
+		m["paused"] = strconv.FormatBool(*r.Paused)
+	}
+
+	if r.Resolution != 0 {
+		m["resolution"] = strconv.Itoa(r.Resolution)
+	}
+
+	return m
+}
@@ -1,7 +1,9 @@
 package pingdom
 
 import (
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -395,10 +397,12 @@ func TestDNSCheckValid(t *testing.T) {
 }
 
 func TestValidCommonParameters(t *testing.T) {
-	assert.Error(t, validCommonParameters("", "example.com", 5))
-	assert.Error(t, validCommonParameters("Test Name", "", 5))
-	assert.Error(t, validCommonParameters("Test Name", "example.com", 7))
-	assert.NoError(t, validCommonParameters("Test Name", "example.com", 0))
+	assert.Error(t, validCommonParameters("", "example.com", 5, ""))
+	assert.Error(t, validCommonParameters("Test Name", "", 5, ""))
+	assert.Error(t, validCommonParameters("Test Name", "example.com", 7, ""))
+	assert.Error(t, validCommonParameters("Test Name", "example.com", 5, "region: Europe"))
+	assert.NoError(t, validCommonParameters("Test Name", "example.com", 0, ""))
+	assert.NoError(t, validCommonParameters("Test Name", "example.com", 0, "region: NA,region: EU"))
 }
 
 func TestSummaryPerformanceRequestValid(t *testing.T) {
@@ -425,6 +429,24 @@ func TestSummaryPerformanceRequestValid(t *testing.T) {
 		}.Valid())
 
 	})
+
+	t.Run("order", func(t *testing.T) {
+		assert.Nil(t, SummaryPerformanceRequest{Id: 123, Order: "asc"}.Valid())
+		assert.Equal(t, ErrBadOrder, SummaryPerformanceRequest{Id: 123, Order: "up"}.Valid())
+	})
+
+	t.Run("period", func(t *testing.T) {
+		from := time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC)
+		assert.Nil(t, SummaryPerformanceRequest{Id: 123, FromTime: from, ToTime: to}.Valid())
+		assert.Equal(t, ErrBadPeriod, SummaryPerformanceRequest{Id: 123, FromTime: to, ToTime: from}.Valid())
+		assert.Equal(t, ErrBadPeriod, SummaryPerformanceRequest{Id: 123, From: 200, To: 100}.Valid())
+		assert.Error(t, SummaryPerformanceRequest{Id: 123, Period: -time.Hour}.Valid())
+	})
+
+	t.Run("probes", func(t *testing.T) {
+		assert.Error(t, SummaryPerformanceRequest{Id: 123, Probes: "1", ProbeIDs: []int{2}}.Valid())
+	})
 }
 
 func TestSummaryPerformanceRequestGetParams(t *testing.T) {
@@ -453,4 +475,209 @@ func TestSummaryPerformanceRequestGetParams(t *testing.T) {
 
 		assert.Equal(t, want, params)
 	})
+
+	t.Run("with all params", func(t *testing.T) {
+		want := map[string]string{
+			"resolution":    "day",
+			"includeuptime": "true",
+			"from":          "1614556800",
+			"to":            "1617235200",
+			"order":         "asc",
+			"probes":        "1,2,3",
+		}
+
+		params := SummaryPerformanceRequest{
+			Id:            id,
+			IncludeUptime: true,
+			Resolution:    "day",
+			FromTime:      time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC),
+			ToTime:        time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC),
+			Order:         "asc",
+			ProbeIDs:      []int{1, 2, 3},
+		}.GetParams()
+
+		assert.Equal(t, want, params)
+	})
+
+	t.Run("with unix timestamps", func(t *testing.T) {
+		params := SummaryPerformanceRequest{Id: id, From: 100, To: 200, Probes: "4"}.GetParams()
+		assert.Equal(t, map[string]string{"from": "100", "to": "200", "probes": "4"}, params)
+	})
+
+	t.Run("with period", func(t *testing.T) {
+		to := time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC)
+		params := SummaryPerformanceRequest{Id: id, ToTime: to, Period: 24 * time.Hour}.GetParams()
+		assert.Equal(t, map[string]string{"from": "1617148800", "to": "1617235200"}, params)
+
+		params = SummaryPerformanceRequest{Id: id, Period: time.Hour}.GetParams()
+		from, _ := strconv.ParseInt(params["from"], 10, 64)
+		until, _ := strconv.ParseInt(params["to"], 10, 64)
+		assert.Equal(t, int64(3600), until-from)
+	})
+}
+
+func TestListChecksOptionsParams(t *testing.T) {
+	assert.Equal(t, map[string]string{}, ListChecksOptions{}.Params())
+
+	opts := ListChecksOptions{
+		Limit:           100,
+		Offset:          200,
+		ShowEncryption:  true,
+		IncludeTags:     true,
+		IncludeSeverity: true,
+		IncludeTeams:    true,
+		Tags:            []string{"apache", "nginx"},
+	}
+	want := map[string]string{
+		"limit":            "100",
+		"offset":           "200",
+		"showencryption":   "true",
+		"include_tags":     "true",
+		"include_severity": "true",
+		"include_teams":    "true",
+		"tags":             "apache,nginx",
+	}
+	assert.Equal(t, want, opts.Params())
+}
+
+func TestSMTPCheckPostParams(t *testing.T) {
+	check := SMTPCheck{
+		Name:           "fake check",
+		Hostname:       "mail.example.com",
+		Port:           587,
+		Username:       "user",
+		Password:       "pass",
+		StringToExpect: "220",
+		Encryption:     true,
+		Resolution:     5,
+		UserIds:        []int{1, 2},
+	}
+	want := map[string]string{
+		"name":             "fake check",
+		"host":             "mail.example.com",
+		"port":             "587",
+		"auth":             "user:pass",
+		"stringtoexpect":   "220",
+		"encryption":       "true",
+		"ipv6":             "false",
+		"notifyagainevery": "0",
+		"notifywhenbackup": "false",
+		"paused":           "false",
+		"resolution":       "5",
+		"userids":          "1,2",
+		"type":             "smtp",
+	}
+	assert.Equal(t, want, check.PostParams())
+}
+
+func TestSMTPCheckValid(t *testing.T) {
+	check := SMTPCheck{Name: "fake check", Hostname: "mail.example.com"}
+	assert.NoError(t, check.Valid())
+
+	badPortCheck := SMTPCheck{Name: "fake check", Hostname: "mail.example.com", Port: 70000}
+	assert.Error(t, badPortCheck.Valid())
+
+	badCheck := SMTPCheck{Name: "fake check"}
+	assert.Error(t, badCheck.Valid())
+}
+
+func TestPOP3CheckPutParams(t *testing.T) {
+	check := POP3Check{Name: "fake check", Hostname: "mail.example.com", Port: 995, Encryption: true, StringToExpect: "+OK"}
+	want := map[string]string{
+		"custom_message":   "",
+		"name":             "fake check",
+		"host":             "mail.example.com",
+		"port":             "995",
+		"stringtoexpect":   "+OK",
+		"encryption":       "true",
+		"integrationids":   "",
+		"ipv6":             "false",
+		"notifyagainevery": "0",
+		"notifywhenbackup": "false",
+		"paused":           "false",
+		"probe_filters":    "",
+		"tags":             "",
+		"teamids":          "",
+		"userids":          "",
+	}
+	assert.Equal(t, want, check.PutParams())
+	assert.Equal(t, "pop3", check.PostParams()["type"])
+	assert.NoError(t, check.Valid())
+}
+
+func TestIMAPCheckPostParams(t *testing.T) {
+	check := IMAPCheck{Name: "fake check", Hostname: "mail.example.com", StringToExpect: "* OK"}
+	want := map[string]string{
+		"name":             "fake check",
+		"host":             "mail.example.com",
+		"stringtoexpect":   "* OK",
+		"encryption":       "false",
+		"ipv6":             "false",
+		"notifyagainevery": "0",
+		"notifywhenbackup": "false",
+		"paused":           "false",
+		"type":             "imap",
+	}
+	assert.Equal(t, want, check.PostParams())
+	assert.NoError(t, check.Valid())
+}
+
+func TestUDPCheckPostParams(t *testing.T) {
+	check := UDPCheck{
+		Name:           "fake check",
+		Hostname:       "game.example.com",
+		Port:           27015,
+		StringToSend:   "ping",
+		StringToExpect: "pong",
+		Resolution:     1,
+	}
+	want := map[string]string{
+		"name":             "fake check",
+		"host":             "game.example.com",
+		"port":             "27015",
+		"stringtosend":     "ping",
+		"stringtoexpect":   "pong",
+		"ipv6":             "false",
+		"notifyagainevery": "0",
+		"notifywhenbackup": "false",
+		"paused":           "false",
+		"resolution":       "1",
+		"type":             "udp",
+	}
+	assert.Equal(t, want, check.PostParams())
+}
+
+func TestUDPCheckValid(t *testing.T) {
+	check := UDPCheck{Name: "fake check", Hostname: "example.com", Port: 53, StringToSend: "a", StringToExpect: "b"}
+	assert.NoError(t, check.Valid())
+
+	noPortCheck := UDPCheck{Name: "fake check", Hostname: "example.com", StringToSend: "a", StringToExpect: "b"}
+	assert.Error(t, noPortCheck.Valid())
+
+	noSendCheck := UDPCheck{Name: "fake check", Hostname: "example.com", Port: 53, StringToExpect: "b"}
+	assert.Error(t, noSendCheck.Valid())
+
+	noExpectCheck := UDPCheck{Name: "fake check", Hostname: "example.com", Port: 53, StringToSend: "a"}
+	assert.Error(t, noExpectCheck.Valid())
+}
+
+func TestBulkModifyRequestValid(t *testing.T) {
+	paused := true
+
+	assert.NoError(t, BulkModifyRequest{CheckIDs: []int{1, 2}, Paused: &paused}.Valid())
+	assert.NoError(t, BulkModifyRequest{AllChecks: true, Resolution: 15}.Valid())
+
+	assert.Error(t, BulkModifyRequest{Paused: &paused}.Valid())
+	assert.Error(t, BulkModifyRequest{CheckIDs: []int{1}, AllChecks: true, Paused: &paused}.Valid())
+	assert.Error(t, BulkModifyRequest{CheckIDs: []int{1}}.Valid())
+	assert.Error(t, BulkModifyRequest{CheckIDs: []int{1}, Resolution: 7}.Valid())
+}
+
+func TestBulkModifyRequestPutParams(t *testing.T) {
+	paused := false
+	params := BulkModifyRequest{CheckIDs: []int{1, 2, 3}, Paused: &paused, Resolution: 5}.PutParams()
+	assert.Equal(t, map[string]string{"checkids": "1,2,3", "paused": "false", "resolution": "5"}, params)
+
+	params = BulkModifyRequest{AllChecks: true, Resolution: 60}.PutParams()
+	assert.Equal(t, map[string]string{"resolution": "60"}, params)
 }
@@ -1,9 +1,8 @@
 package pingdom
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
-	"io/ioutil"
 	"strconv"
 )
 
@@ -20,34 +19,33 @@ type ContactAPI interface {
 
 // List returns a list of all contacts and their contact details.
 func (cs *ContactService) List() ([]Contact, error) {
+	return cs.ListContext(context.Background())
+}
 
-	req, err := cs.client.NewRequest("GET", "/alerting/contacts", nil)
+// ListContext is like List but uses the given context for the request.
+func (cs *ContactService) ListContext(ctx context.Context) ([]Contact, error) {
+	req, err := cs.client.NewRequestWithContext(ctx, "GET", "/alerting/contacts", nil)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := cs.client.client.Do(req)
+	u := &listContactsJSONResponse{}
+	_, err = cs.client.Do(req, u)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	if err := validateResponse(resp); err != nil {
-		return nil, err
-	}
-
-	bodyBytes, _ := ioutil.ReadAll(resp.Body)
-	bodyString := string(bodyBytes)
-
-	u := &listContactsJSONResponse{}
-	err = json.Unmarshal([]byte(bodyString), &u)
 
 	return u.Contacts, err
 }
 
 // Read return a contact object from Pingdom.
 func (cs *ContactService) Read(contactID int) (*Contact, error) {
-	req, err := cs.client.NewRequest("GET", "/alerting/contacts/"+strconv.Itoa(contactID), nil)
+	return cs.ReadContext(context.Background(), contactID)
+}
+
+// ReadContext is like Read but uses the given context for the request.
+func (cs *ContactService) ReadContext(ctx context.Context, contactID int) (*Contact, error) {
+	req, err := cs.client.NewRequestWithContext(ctx, "GET", "/alerting/contacts/"+strconv.Itoa(contactID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -63,11 +61,16 @@ func (cs *ContactService) Read(contactID int) (*Contact, error) {
 
 // Create adds a new contact.
 func (cs *ContactService) Create(contact ContactAPI) (*Contact, error) {
+	return cs.CreateContext(context.Background(), contact)
+}
+
+// CreateContext is like Create but uses the given context for the request.
+func (cs *ContactService) CreateContext(ctx context.Context, contact ContactAPI) (*Contact, error) {
 	if err := contact.ValidContact(); err != nil {
 		return nil, err
 	}
 
-	req, err := cs.client.NewJSONRequest("POST", "/alerting/contacts", contact.RenderForJSONAPI())
+	req, err := cs.client.NewJSONRequestWithContext(ctx, "POST", "/alerting/contacts", contact.RenderForJSONAPI())
 	if err != nil {
 		return nil, err
 	}
@@ -83,11 +86,16 @@ func (cs *ContactService) Create(contact ContactAPI) (*Contact, error) {
 
 // Update a contact's core properties not contact targets.
 func (cs *ContactService) Update(id int, contact ContactAPI) (*PingdomResponse, error) {
+	return cs.UpdateContext(context.Background(), id, contact)
+}
+
+// UpdateContext is like Update but uses the given context for the request.
+func (cs *ContactService) UpdateContext(ctx context.Context, id int, contact ContactAPI) (*PingdomResponse, error) {
 	if err := contact.ValidContact(); err != nil {
 		return nil, err
 	}
 
-	req, err := cs.client.NewJSONRequest("PUT", "/alerting/contacts/"+strconv.Itoa(id), contact.RenderForJSONAPI())
+	req, err := cs.client.NewJSONRequestWithContext(ctx, "PUT", "/alerting/contacts/"+strconv.Itoa(id), contact.RenderForJSONAPI())
 	if err != nil {
 		return nil, err
 	}
@@ -102,7 +110,12 @@ func (cs *ContactService) Update(id int, contact ContactAPI) (*PingdomResponse,
 
 // Delete removes a contact from Pingdom.
 func (cs *ContactService) Delete(id int) (*PingdomResponse, error) {
-	req, err := cs.client.NewRequest("DELETE", "/alerting/contacts/"+strconv.Itoa(id), nil)
+	return cs.DeleteContext(context.Background(), id)
+}
+
+// DeleteContext is like Delete but uses the given context for the request.
+func (cs *ContactService) DeleteContext(ctx context.Context, id int) (*PingdomResponse, error) {
+	req, err := cs.client.NewRequestWithContext(ctx, "DELETE", "/alerting/contacts/"+strconv.Itoa(id), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -3,8 +3,42 @@ package pingdom
 import (
 	"encoding/json"
 	"fmt"
+	"net/mail"
+	"strings"
 )
 
+// smsProviders are the values Pingdom accepts for SMSNotification.Provider.
+var smsProviders = []string{"nexmo", "bulksms", "esendex", "cellsynt"}
+
+// notificationSeverities are the values Pingdom accepts for the severity of a notification target.
+var notificationSeverities = []string{"HIGH", "LOW"}
+
+// NotificationTargetError describes a single invalid field of a notification target.
+type NotificationTargetError struct {
+	// Path locates the field, e.g. "notification_targets.sms[0].number".
+	Path string
+	Err  error
+}
+
+func (e *NotificationTargetError) Error() string {
+	return e.Path + ": " + e.Err.Error()
+}
+
+func (e *NotificationTargetError) Unwrap() error {
+	return e.Err
+}
+
+// NotificationTargetsError lists every invalid field found in a contact's notification targets.
+type NotificationTargetsError []*NotificationTargetError
+
+func (e NotificationTargetsError) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return fmt.Sprintf("%d invalid notification target field(s): %s", len(e), strings.Join(msgs, "; "))
+}
+
 // NotificationTargets represents different ways a contact could be notified of alerts
 type NotificationTargets struct {
 	SMS   []SMSNotification   `json:"sms,omitempty"`
@@ -63,9 +97,130 @@ func (c *Contact) ValidContact() error {
 		return fmt.Errorf("Invalid value for `Name`.  Must contain non-empty string")
 	}
 
+	return c.NotificationTargets.Valid()
+}
+
+// Normalize rewrites the notification targets into the form Pingdom expects:
+// phone numbers are stripped of "+", spaces and punctuation, providers are
+// lower-cased, severities upper-cased and email addresses trimmed.
+func (c *Contact) Normalize() {
+	t := &c.NotificationTargets
+	for i := range t.SMS {
+		sms := &t.SMS[i]
+		sms.CountryCode = digitsOnly(sms.CountryCode)
+		sms.Number = digitsOnly(sms.Number)
+		sms.Provider = strings.ToLower(strings.TrimSpace(sms.Provider))
+		sms.Severity = normalizeSeverity(sms.Severity)
+	}
+	for i := range t.Email {
+		t.Email[i].Address = strings.TrimSpace(t.Email[i].Address)
+		t.Email[i].Severity = normalizeSeverity(t.Email[i].Severity)
+	}
+	for i := range t.APNS {
+		t.APNS[i].Severity = normalizeSeverity(t.APNS[i].Severity)
+	}
+	for i := range t.AGCM {
+		t.AGCM[i].Severity = normalizeSeverity(t.AGCM[i].Severity)
+	}
+}
+
+// Valid determines whether all notification targets contain valid fields.
+// The returned error is a NotificationTargetsError listing every invalid field.
+func (t NotificationTargets) Valid() error {
+	var errs NotificationTargetsError
+	add := func(path string, err error) {
+		if err != nil {
+			errs = append(errs, &NotificationTargetError{Path: "notification_targets." + path, Err: err})
+		}
+	}
+
+	for i, sms := range t.SMS {
+		p := fmt.Sprintf("sms[%d].", i)
+		add(p+"country_code", validCountryCode(sms.CountryCode))
+		add(p+"number", validPhoneNumber(sms.CountryCode, sms.Number))
+		if sms.Provider != "" && !stringInSlice(sms.Provider, smsProviders) {
+			add(p+"provider", fmt.Errorf("Invalid value %q for `Provider`.  Must be one of %s", sms.Provider, strings.Join(smsProviders, ", ")))
+		}
+		add(p+"severity", validSeverity(sms.Severity))
+	}
+	for i, email := range t.Email {
+		p := fmt.Sprintf("email[%d].", i)
+		add(p+"address", validEmailAddress(email.Address))
+		add(p+"severity", validSeverity(email.Severity))
+	}
+	for i, apns := range t.APNS {
+		add(fmt.Sprintf("apns[%d].severity", i), validSeverity(apns.Severity))
+	}
+	for i, agcm := range t.AGCM {
+		add(fmt.Sprintf("agcm[%d].severity", i), validSeverity(agcm.Severity))
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func validSeverity(severity string) error {
+	if severity != "" && !stringInSlice(severity, notificationSeverities) {
+		return fmt.Errorf("Invalid value %q for `Severity`.  Must be HIGH or LOW when set", severity)
+	}
+	return nil
+}
+
+func validCountryCode(cc string) error {
+	if cc == "" || len(cc) > 3 || !isDigits(cc) {
+		return fmt.Errorf("Invalid value %q for `CountryCode`.  Must be 1-3 digits", cc)
+	}
 	return nil
 }
 
+// validPhoneNumber checks that the number is a national subscriber number
+// which, together with its country code, forms a valid E.164 number.
+func validPhoneNumber(cc, number string) error {
+	if number == "" || !isDigits(number) {
+		return fmt.Errorf("Invalid value %q for `Number`.  Must contain digits only", number)
+	}
+	if number[0] == '0' {
+		return fmt.Errorf("Invalid value %q for `Number`.  Must not include the national trunk prefix 0", number)
+	}
+	if n := len(cc) + len(number); n > 15 {
+		return fmt.Errorf("Invalid value %q for `Number`.  E.164 numbers have at most 15 digits including country code, got %d", number, n)
+	}
+	return nil
+}
+
+func validEmailAddress(address string) error {
+	addr, err := mail.ParseAddress(address)
+	if err != nil || addr.Address != address {
+		return fmt.Errorf("Invalid value %q for `Address`.  Must be a plain email address", address)
+	}
+	return nil
+}
+
+func normalizeSeverity(severity string) string {
+	return strings.ToUpper(strings.TrimSpace(severity))
+}
+
+func digitsOnly(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if r >= '0' && r <= '9' {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
 // RenderForJSONAPI returns the JSON formatted version of this object that may be submitted to Pingdom
 func (c *Contact) RenderForJSONAPI() string {
 	u := map[string]interface{}{
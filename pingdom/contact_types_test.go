@@ -1,6 +1,7 @@
 package pingdom
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
@@ -29,3 +30,94 @@ func TestContact_ValidContact_Negative(t *testing.T) {
 
 	assert.Equal(t, want, err, "Contact.ValidContact() should return error")
 }
+
+func TestContact_ValidContact_NotificationTargets(t *testing.T) {
+	contact := Contact{
+		Name: "testName",
+		NotificationTargets: NotificationTargets{
+			SMS: []SMSNotification{
+				{CountryCode: "46", Number: "701234567", Provider: "nexmo", Severity: "HIGH"},
+				{CountryCode: "+1", Number: "0555123", Provider: "Verizon", Severity: "high"},
+				{CountryCode: "00", Number: "5555555555", Provider: "nexmo"},
+			},
+			Email: []EmailNotification{
+				{Address: "john@example.com", Severity: "LOW"},
+				{Address: "John <john@example.com>", Severity: "LOW"},
+			},
+			APNS: []APNSNotification{{Device: "abc", Severity: "MEDIUM"}},
+			AGCM: []AGCMNotification{{AGCMID: "abc", Severity: "LOW"}},
+		},
+	}
+
+	err := contact.ValidContact()
+	var targetsErr NotificationTargetsError
+	assert.True(t, errors.As(err, &targetsErr))
+
+	var paths []string
+	for _, e := range targetsErr {
+		paths = append(paths, e.Path)
+	}
+	assert.Equal(t, []string{
+		"notification_targets.sms[1].country_code",
+		"notification_targets.sms[1].number",
+		"notification_targets.sms[1].provider",
+		"notification_targets.sms[1].severity",
+		"notification_targets.email[1].address",
+		"notification_targets.apns[0].severity",
+	}, paths)
+	assert.Contains(t, err.Error(), "notification_targets.sms[1].provider: ")
+}
+
+func TestNotificationTargets_Valid_E164(t *testing.T) {
+	tests := []struct {
+		countryCode string
+		number      string
+		wantErr     bool
+	}{
+		{countryCode: "1", number: "5555555555"},
+		{countryCode: "353", number: "871234567"},
+		{countryCode: "00", number: "5555555555"},
+		{countryCode: "", number: "5555555555", wantErr: true},
+		{countryCode: "1234", number: "5555555555", wantErr: true},
+		{countryCode: "1", number: "555-555-5555", wantErr: true},
+		{countryCode: "44", number: "1234567890123456", wantErr: true},
+		{countryCode: "44", number: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		targets := NotificationTargets{
+			SMS: []SMSNotification{{CountryCode: tt.countryCode, Number: tt.number, Severity: "HIGH"}},
+		}
+		err := targets.Valid()
+		if tt.wantErr {
+			assert.Error(t, err, "%s %s", tt.countryCode, tt.number)
+		} else {
+			assert.NoError(t, err, "%s %s", tt.countryCode, tt.number)
+		}
+	}
+}
+
+func TestContact_Normalize(t *testing.T) {
+	contact := Contact{
+		Name: "testName",
+		NotificationTargets: NotificationTargets{
+			SMS: []SMSNotification{
+				{CountryCode: "+1", Number: "(555) 555-5555", Provider: " Nexmo", Severity: "high"},
+			},
+			Email: []EmailNotification{{Address: " john@example.com ", Severity: "low"}},
+			APNS:  []APNSNotification{{Device: "abc", Severity: "Low"}},
+			AGCM:  []AGCMNotification{{AGCMID: "abc", Severity: "High"}},
+		},
+	}
+
+	contact.Normalize()
+
+	assert.Equal(t, NotificationTargets{
+		SMS: []SMSNotification{
+			{CountryCode: "1", Number: "5555555555", Provider: "nexmo", Severity: "HIGH"},
+		},
+		Email: []EmailNotification{{Address: "john@example.com", Severity: "LOW"}},
+		APNS:  []APNSNotification{{Device: "abc", Severity: "LOW"}},
+		AGCM:  []AGCMNotification{{AGCMID: "abc", Severity: "HIGH"}},
+	}, contact.NotificationTargets)
+	assert.NoError(t, contact.ValidContact())
+}
@@ -0,0 +1,32 @@
+package pingdom
+
+import (
+	"context"
+)
+
+// CreditsService provides an interface to the Pingdom account credits.
+type CreditsService struct {
+	client *Client
+}
+
+// Get returns the remaining checks, SMS credits and transaction check
+// slots of the account.
+func (cs *CreditsService) Get() (*CreditsResponse, error) {
+	return cs.GetContext(context.Background())
+}
+
+// GetContext is like Get but uses the given context for the request.
+func (cs *CreditsService) GetContext(ctx context.Context) (*CreditsResponse, error) {
+	req, err := cs.client.NewRequestWithContext(ctx, "GET", "/credits", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &creditsJSONResponse{}
+	_, err = cs.client.Do(req, c)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Credits, err
+}
@@ -0,0 +1,51 @@
+package pingdom
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreditsServiceGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/credits", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"credits": {
+				"checklimit": 100,
+				"availablechecks": 42,
+				"useddefault": 55,
+				"availabledefaultchecks": 40,
+				"usedtransaction": 3,
+				"availabletransactionchecks": 2,
+				"availablesms": 200,
+				"availablesmstests": 10,
+				"autofillsms": true,
+				"autofillsms_amount": 100,
+				"autofillsms_when_left": 20,
+				"max_sms_overage": 50
+			}
+		}`)
+	})
+
+	credits, err := client.Credits.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, &CreditsResponse{
+		CheckLimit:                 100,
+		AvailableChecks:            42,
+		UsedDefault:                55,
+		AvailableDefaultChecks:     40,
+		UsedTransaction:            3,
+		AvailableTransactionChecks: 2,
+		AvailableSMS:               200,
+		AvailableSMSTests:          10,
+		AutoFillSMS:                true,
+		AutoFillSMSAmount:          100,
+		AutoFillSMSWhenLeft:        20,
+		MaxSMSOverage:              50,
+	}, credits)
+}
@@ -0,0 +1,72 @@
+package pingdom
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// DiagnosticsService provides an interface to the Pingdom one-off tests,
+// which run from a probe without creating a check.
+type DiagnosticsService struct {
+	client *Client
+}
+
+// SingleTest runs a single test of the given check from a probe and returns
+// its result.  The check is not created.
+func (cs *DiagnosticsService) SingleTest(request SingleTestRequest) (*SingleTestResult, error) {
+	return cs.SingleTestContext(context.Background(), request)
+}
+
+// SingleTestContext is like SingleTest but uses the given context for the
+// request.
+func (cs *DiagnosticsService) SingleTestContext(ctx context.Context, request SingleTestRequest) (*SingleTestResult, error) {
+	if err := request.Valid(); err != nil {
+		return nil, err
+	}
+
+	req, err := cs.client.NewRequestWithContext(ctx, "GET", "/single", request.GetParams())
+	if err != nil {
+		return nil, err
+	}
+
+	s := &singleTestJSONResponse{}
+	_, err = cs.client.Do(req, s)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Result, err
+}
+
+// Traceroute runs a traceroute to host from the given probe.  When probeID
+// is 0, Pingdom picks the probe.
+func (cs *DiagnosticsService) Traceroute(host string, probeID int) (*TracerouteResult, error) {
+	return cs.TracerouteContext(context.Background(), host, probeID)
+}
+
+// TracerouteContext is like Traceroute but uses the given context for the
+// request.
+func (cs *DiagnosticsService) TracerouteContext(ctx context.Context, host string, probeID int) (*TracerouteResult, error) {
+	if host == "" {
+		return nil, fmt.Errorf("invalid value for `host`, must contain non-empty string")
+	}
+
+	params := map[string]string{"host": host}
+	if probeID != 0 {
+		params["probeid"] = strconv.Itoa(probeID)
+	}
+
+	req, err := cs.client.NewRequestWithContext(ctx, "GET", "/traceroute", params)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &tracerouteJSONResponse{}
+	_, err = cs.client.Do(req, t)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.Traceroute, err
+}
@@ -0,0 +1,77 @@
+package pingdom
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiagnosticsServiceSingleTest(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/single", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, "example.com", r.URL.Query().Get("host"))
+		assert.Equal(t, "http", r.URL.Query().Get("type"))
+		assert.Equal(t, "/health", r.URL.Query().Get("url"))
+		assert.Equal(t, "33", r.URL.Query().Get("probeid"))
+		assert.Equal(t, "", r.URL.Query().Get("name"))
+		fmt.Fprint(w, `{
+			"result": {
+				"status": "up",
+				"responsetime": 145,
+				"statusdesc": "OK",
+				"statusdesclong": "OK",
+				"probeid": 33,
+				"probedesc": "Amsterdam 2, Netherlands"
+			}
+		}`)
+	})
+
+	result, err := client.Diagnostics.SingleTest(SingleTestRequest{
+		Check:   &HttpCheck{Name: "health", Hostname: "example.com", Url: "/health", Resolution: 5},
+		ProbeID: 33,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &SingleTestResult{
+		Status:         "up",
+		ResponseTime:   145,
+		StatusDesc:     "OK",
+		StatusDescLong: "OK",
+		ProbeID:        33,
+		ProbeDesc:      "Amsterdam 2, Netherlands",
+	}, result)
+	assert.True(t, result.Up())
+}
+
+func TestDiagnosticsServiceTraceroute(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/traceroute", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, "example.com", r.URL.Query().Get("host"))
+		assert.Equal(t, "33", r.URL.Query().Get("probeid"))
+		fmt.Fprint(w, `{
+			"traceroute": {
+				"result": "traceroute to example.com (93.184.216.34), 30 hops max",
+				"probeid": 33,
+				"probedescription": "Amsterdam 2, Netherlands"
+			}
+		}`)
+	})
+
+	result, err := client.Diagnostics.Traceroute("example.com", 33)
+	assert.NoError(t, err)
+	assert.Equal(t, &TracerouteResult{
+		Result:           "traceroute to example.com (93.184.216.34), 30 hops max",
+		ProbeID:          33,
+		ProbeDescription: "Amsterdam 2, Netherlands",
+	}, result)
+
+	_, err = client.Diagnostics.Traceroute("", 33)
+	assert.Error(t, err)
+}
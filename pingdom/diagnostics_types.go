@@ -0,0 +1,76 @@
+package pingdom
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// singleTestParams are the check parameters accepted by a single test.
+// Requestheader parameters are numbered and matched by prefix.
+var singleTestParams = map[string]bool{
+	"host":             true,
+	"type":             true,
+	"ipv6":             true,
+	"url":              true,
+	"encryption":       true,
+	"port":             true,
+	"auth":             true,
+	"shouldcontain":    true,
+	"shouldnotcontain": true,
+	"postdata":         true,
+	"stringtosend":     true,
+	"stringtoexpect":   true,
+	"expectedip":       true,
+	"nameserver":       true,
+}
+
+// SingleTestRequest is the API request to Pingdom for a SingleTest.  Check
+// is encoded as it would be to create it, and the parameters that do not
+// apply to a single test, such as its name or notification settings, are
+// left out.
+type SingleTestRequest struct {
+	Check   Check
+	ProbeID int
+}
+
+// Valid determines whether a SingleTestRequest contains valid fields for the
+// Pingdom API.  The check itself is not required to have a name or any of
+// the settings of a permanent check.
+func (r SingleTestRequest) Valid() error {
+	if r.Check == nil {
+		return fmt.Errorf("invalid value for `Check`, must not be nil")
+	}
+
+	if r.ProbeID < 0 {
+		return fmt.Errorf("invalid value %v for `ProbeID`, must not be negative", r.ProbeID)
+	}
+
+	params := r.Check.PostParams()
+	if params["host"] == "" {
+		return fmt.Errorf("invalid value for `Hostname`, must contain non-empty string")
+	}
+
+	if params["type"] == "" {
+		return fmt.Errorf("invalid value for `Check`, unknown check type")
+	}
+
+	return nil
+}
+
+// GetParams returns a map of params for a Pingdom SingleTestRequest.
+func (r SingleTestRequest) GetParams() map[string]string {
+	m := map[string]string{}
+
+	for k, v := range r.Check.PostParams() {
+		if singleTestParams[k] || strings.HasPrefix(k, "requestheader") {
+			m[k] = v
+		}
+	}
+
+	if r.ProbeID != 0 {
+		m["probeid"] = strconv.Itoa(r.ProbeID)
+	}
+
+	return m
+}
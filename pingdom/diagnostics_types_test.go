@@ -0,0 +1,85 @@
+package pingdom
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSingleTestRequestValid(t *testing.T) {
+	assert.Error(t, SingleTestRequest{}.Valid())
+	assert.Error(t, SingleTestRequest{Check: &HttpCheck{}}.Valid())
+	assert.Error(t, SingleTestRequest{Check: &HttpCheck{Hostname: "example.com"}, ProbeID: -1}.Valid())
+	assert.NoError(t, SingleTestRequest{Check: &HttpCheck{Hostname: "example.com"}}.Valid())
+}
+
+func TestSingleTestRequestGetParams(t *testing.T) {
+	tests := []struct {
+		name    string
+		request SingleTestRequest
+		want    map[string]string
+	}{
+		{
+			name: "http",
+			request: SingleTestRequest{
+				Check: &HttpCheck{
+					Name:           "fake check",
+					Hostname:       "example.com",
+					Resolution:     5,
+					Url:            "/health",
+					Encryption:     true,
+					ShouldContain:  "ok",
+					Username:       "user",
+					Password:       "secret",
+					UserIds:        []int{1},
+					RequestHeaders: map[string]string{"Accept": "text/plain"},
+				},
+				ProbeID: 33,
+			},
+			want: map[string]string{
+				"host":           "example.com",
+				"type":           "http",
+				"url":            "/health",
+				"encryption":     "true",
+				"ipv6":           "false",
+				"shouldcontain":  "ok",
+				"auth":           "user:secret",
+				"requestheader0": "Accept:text/plain",
+				"probeid":        "33",
+			},
+		},
+		{
+			name: "tcp",
+			request: SingleTestRequest{
+				Check: &TCPCheck{Hostname: "example.com", Port: 25, StringToSend: "HELO", StringToExpect: "250"},
+			},
+			want: map[string]string{
+				"host":           "example.com",
+				"type":           "tcp",
+				"ipv6":           "false",
+				"port":           "25",
+				"stringtosend":   "HELO",
+				"stringtoexpect": "250",
+			},
+		},
+		{
+			name: "dns",
+			request: SingleTestRequest{
+				Check: &DNSCheck{Hostname: "example.com", ExpectedIP: "93.184.216.34", NameServer: "a.iana-servers.net"},
+			},
+			want: map[string]string{
+				"host":       "example.com",
+				"type":       "dns",
+				"ipv6":       "false",
+				"expectedip": "93.184.216.34",
+				"nameserver": "a.iana-servers.net",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.request.GetParams())
+		})
+	}
+}
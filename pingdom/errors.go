@@ -0,0 +1,55 @@
+package pingdom
+
+import (
+	"errors"
+	"net/http"
+)
+
+// maxErrorBodyLength is the number of bytes of a failed response body kept
+// in a PingdomError.
+const maxErrorBodyLength = 1024
+
+// Sentinel errors matching the class of a failed Pingdom API call.  A
+// *PingdomError returned by the client matches them with errors.Is, e.g.
+//
+//	if errors.Is(err, pingdom.ErrNotFound) {
+//		// already deleted
+//	}
+var (
+	// ErrNotFound matches 404 Not Found responses.
+	ErrNotFound = errors.New("pingdom: resource not found")
+	// ErrUnauthorized matches 401 Unauthorized and 403 Forbidden responses.
+	ErrUnauthorized = errors.New("pingdom: unauthorized")
+	// ErrRateLimited matches 429 Too Many Requests responses.
+	ErrRateLimited = errors.New("pingdom: rate limited")
+	// ErrValidation matches 400 Bad Request and 422 Unprocessable Entity
+	// responses.
+	ErrValidation = errors.New("pingdom: validation failed")
+	// ErrServer matches 5xx responses.
+	ErrServer = errors.New("pingdom: server error")
+)
+
+// Is reports whether the error belongs to the class of the target sentinel
+// error.
+func (r *PingdomError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return r.StatusCode == http.StatusNotFound
+	case ErrUnauthorized:
+		return r.StatusCode == http.StatusUnauthorized || r.StatusCode == http.StatusForbidden
+	case ErrRateLimited:
+		return r.StatusCode == http.StatusTooManyRequests
+	case ErrValidation:
+		return r.StatusCode == http.StatusBadRequest || r.StatusCode == http.StatusUnprocessableEntity
+	case ErrServer:
+		return r.StatusCode >= 500 && r.StatusCode <= 599
+	}
+	return false
+}
+
+func truncateErrorBody(b []byte) string {
+	if len(b) > maxErrorBodyLength {
+		return string(b[:maxErrorBodyLength]) + "..."
+	}
+	return string(b)
+}
@@ -0,0 +1,106 @@
+package pingdom
+
+import (
+	"context"
+	"strconv"
+)
+
+// defaultPageSize is the page size used by iterators when none is given.
+const defaultPageSize = 100
+
+// Iterator pages lazily through a Pingdom list endpoint using the `limit`
+// and `offset` parameters.  A new page is only requested once the previous
+// one has been consumed, so stopping early does not fetch further pages.
+//
+//	it := client.Checks.ListAll(ctx, 500)
+//	for it.Next() {
+//		check := it.Value()
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type Iterator[T any] struct {
+	ctx      context.Context
+	fetch    func(ctx context.Context, limit, offset int) ([]T, error)
+	pageSize int
+	offset   int
+	page     []T
+	index    int
+	current  T
+	done     bool
+	err      error
+}
+
+func newIterator[T any](ctx context.Context, pageSize int, fetch func(ctx context.Context, limit, offset int) ([]T, error)) *Iterator[T] {
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	return &Iterator[T]{ctx: ctx, fetch: fetch, pageSize: pageSize}
+}
+
+// Next advances the iterator to the next item, fetching a new page if
+// required.  It returns false when there are no more items or an error
+// occurred, in which case Err returns it.
+func (it *Iterator[T]) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	for it.index >= len(it.page) {
+		if it.done {
+			return false
+		}
+		if err := it.ctx.Err(); err != nil {
+			it.err = err
+			return false
+		}
+
+		page, err := it.fetch(it.ctx, it.pageSize, it.offset)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.page = page
+		it.index = 0
+		it.offset += len(page)
+		if len(page) < it.pageSize {
+			it.done = true
+		}
+	}
+
+	it.current = it.page[it.index]
+	it.index++
+	return true
+}
+
+// Value returns the current item.
+func (it *Iterator[T]) Value() T {
+	return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// pageParams returns a copy of params with the paging parameters set.
+func pageParams(params map[string]string, limit, offset int) map[string]string {
+	m := make(map[string]string, len(params)+2)
+	for k, v := range params {
+		m[k] = v
+	}
+	m["limit"] = strconv.Itoa(limit)
+	m["offset"] = strconv.Itoa(offset)
+	return m
+}
+
+// mergeParams flattens a list of parameter maps into one.
+func mergeParams(params []map[string]string) map[string]string {
+	m := map[string]string{}
+	for _, p := range params {
+		for k, v := range p {
+			m[k] = v
+		}
+	}
+	return m
+}
@@ -0,0 +1,215 @@
+package pingdom
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIterator(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5}
+	var calls [][2]int
+	it := newIterator(context.Background(), 2, func(ctx context.Context, limit, offset int) ([]int, error) {
+		calls = append(calls, [2]int{limit, offset})
+		end := offset + limit
+		if end > len(items) {
+			end = len(items)
+		}
+		return items[offset:end], nil
+	})
+
+	var got []int
+	for it.Next() {
+		got = append(got, it.Value())
+	}
+	assert.NoError(t, it.Err())
+	assert.Equal(t, items, got)
+	assert.Equal(t, [][2]int{{2, 0}, {2, 2}, {2, 4}}, calls)
+}
+
+func TestIteratorExactPages(t *testing.T) {
+	calls := 0
+	it := newIterator(context.Background(), 2, func(ctx context.Context, limit, offset int) ([]int, error) {
+		calls++
+		if offset >= 4 {
+			return nil, nil
+		}
+		return []int{offset, offset + 1}, nil
+	})
+
+	count := 0
+	for it.Next() {
+		count++
+	}
+	assert.NoError(t, it.Err())
+	assert.Equal(t, 4, count)
+	assert.Equal(t, 3, calls)
+}
+
+func TestIteratorEarlyTermination(t *testing.T) {
+	calls := 0
+	it := newIterator(context.Background(), 2, func(ctx context.Context, limit, offset int) ([]int, error) {
+		calls++
+		return []int{offset, offset + 1}, nil
+	})
+
+	assert.True(t, it.Next())
+	assert.True(t, it.Next())
+	assert.Equal(t, 1, it.Value())
+	assert.Equal(t, 1, calls)
+}
+
+func TestIteratorError(t *testing.T) {
+	fail := errors.New("boom")
+	it := newIterator(context.Background(), 2, func(ctx context.Context, limit, offset int) ([]int, error) {
+		if offset > 0 {
+			return nil, fail
+		}
+		return []int{1, 2}, nil
+	})
+
+	assert.True(t, it.Next())
+	assert.True(t, it.Next())
+	assert.False(t, it.Next())
+	assert.Equal(t, fail, it.Err())
+	assert.False(t, it.Next())
+}
+
+func TestIteratorCancelledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	it := newIterator(ctx, 0, func(ctx context.Context, limit, offset int) ([]int, error) {
+		t.Fatal("fetch should not be called")
+		return nil, nil
+	})
+
+	assert.False(t, it.Next())
+	assert.True(t, errors.Is(it.Err(), context.Canceled))
+}
+
+func TestCheckServiceListAll(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/checks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, "true", r.URL.Query().Get("include_tags"))
+		assert.Equal(t, "2", r.URL.Query().Get("limit"))
+		switch r.URL.Query().Get("offset") {
+		case "0":
+			fmt.Fprint(w, `{"checks": [{"id": 1}, {"id": 2}]}`)
+		case "2":
+			fmt.Fprint(w, `{"checks": [{"id": 3}]}`)
+		default:
+			t.Errorf("unexpected offset %v", r.URL.Query().Get("offset"))
+		}
+	})
+
+	var ids []int
+	it := client.Checks.ListAll(context.Background(), 2, map[string]string{"include_tags": "true"})
+	for it.Next() {
+		ids = append(ids, it.Value().ID)
+	}
+	assert.NoError(t, it.Err())
+	assert.Equal(t, []int{1, 2, 3}, ids)
+}
+
+func TestCheckServiceResultsAll(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/results/42", func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		if offset == 0 {
+			fmt.Fprint(w, `{"activeprobes": [1], "results": [{"probeid": 1, "time": 100}, {"probeid": 1, "time": 99}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"activeprobes": [1], "results": []}`)
+	})
+
+	var times []int
+	it := client.Checks.ResultsAll(context.Background(), 42, 2)
+	for it.Next() {
+		times = append(times, it.Value().Time)
+	}
+	assert.NoError(t, it.Err())
+	assert.Equal(t, []int{100, 99}, times)
+}
+
+func TestCheckServiceResultsAllCapsPageSize(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var offsets []string
+	mux.HandleFunc("/results/42", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "1000", r.URL.Query().Get("limit"))
+		offsets = append(offsets, r.URL.Query().Get("offset"))
+		if r.URL.Query().Get("offset") != "0" {
+			fmt.Fprint(w, `{"results": [{"probeid": 1, "time": 1}]}`)
+			return
+		}
+		results := make([]string, maxResultsLimit)
+		for i := range results {
+			results[i] = `{"probeid": 1, "time": 2}`
+		}
+		fmt.Fprintf(w, `{"results": [%s]}`, strings.Join(results, ","))
+	})
+
+	n := 0
+	it := client.Checks.ResultsAll(context.Background(), 42, 5000)
+	for it.Next() {
+		n++
+	}
+	assert.NoError(t, it.Err())
+	assert.Equal(t, []string{"0", "1000"}, offsets)
+	assert.Equal(t, maxResultsLimit+1, n)
+}
+
+func TestCheckServiceListAllCapsPageSize(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var offsets []string
+	mux.HandleFunc("/checks", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "25000", r.URL.Query().Get("limit"))
+		offsets = append(offsets, r.URL.Query().Get("offset"))
+		if r.URL.Query().Get("offset") != "0" {
+			fmt.Fprint(w, `{"checks": [{"id": 1}]}`)
+			return
+		}
+		checks := make([]string, maxChecksLimit)
+		for i := range checks {
+			checks[i] = `{"id": 2}`
+		}
+		fmt.Fprintf(w, `{"checks": [%s]}`, strings.Join(checks, ","))
+	})
+
+	n := 0
+	it := client.Checks.ListAll(context.Background(), 30000)
+	for it.Next() {
+		n++
+	}
+	assert.NoError(t, it.Err())
+	assert.Equal(t, []string{"0", "25000"}, offsets)
+	assert.Equal(t, maxChecksLimit+1, n)
+}
+
+func TestMaintenanceServiceListAll(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/maintenance", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"error":{"statuscode":404,"statusdesc":"Not Found","errormessage":"nope"}}`)
+	})
+
+	it := client.Maintenances.ListAll(context.Background(), 10)
+	assert.False(t, it.Next())
+	assert.True(t, errors.Is(it.Err(), ErrNotFound))
+}
@@ -1,8 +1,9 @@
 package pingdom
 
 import (
-	"encoding/json"
-	"io/ioutil"
+	"context"
+	"fmt"
+	"net/http"
 	"strconv"
 )
 
@@ -19,48 +20,71 @@ type Maintenance interface {
 }
 
 // MaintenanceDelete is the set of parameters to a Pingdom maintenance delete request.
+//
+// Deprecated: MultiDelete takes the IDs of the maintenance windows.
 type MaintenanceDelete interface {
 	DeleteParams() map[string]string
 	ValidDelete() error
 }
 
-// List returns the response holding a list of Maintenance windows.
-func (cs *MaintenanceService) List(params ...map[string]string) ([]MaintenanceResponse, error) {
-	param := map[string]string{}
-	if len(params) != 0 {
-		for _, m := range params {
-			for k, v := range m {
-				param[k] = v
-			}
-		}
+// jsonMaintenance is a Maintenance that can be sent as a JSON body.
+type jsonMaintenance interface {
+	RenderForJSONAPI() string
+}
+
+// List returns the response holding a list of Maintenance windows.  At most
+// one ListMaintenanceOptions is used.
+func (cs *MaintenanceService) List(opts ...ListMaintenanceOptions) ([]MaintenanceResponse, error) {
+	return cs.ListContext(context.Background(), opts...)
+}
+
+// ListContext is like List but uses the given context for the request.
+func (cs *MaintenanceService) ListContext(ctx context.Context, opts ...ListMaintenanceOptions) ([]MaintenanceResponse, error) {
+	var o ListMaintenanceOptions
+	if len(opts) != 0 {
+		o = opts[0]
 	}
-	req, err := cs.client.NewRequest("GET", "/maintenance", param)
-	if err != nil {
+	if err := o.Valid(); err != nil {
 		return nil, err
 	}
 
-	resp, err := cs.client.client.Do(req)
+	req, err := cs.client.NewRequestWithContext(ctx, "GET", "/maintenance", o.Params())
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
 
-	if err := validateResponse(resp); err != nil {
+	m := &listMaintenanceJSONResponse{}
+	_, err = cs.client.Do(req, m)
+	if err != nil {
 		return nil, err
 	}
 
-	bodyBytes, _ := ioutil.ReadAll(resp.Body)
-	bodyString := string(bodyBytes)
-
-	m := &listMaintenanceJSONResponse{}
-	err = json.Unmarshal([]byte(bodyString), &m)
-
 	return m.Maintenances, err
 }
 
+// ListAll returns an iterator over all Maintenance windows, fetching them
+// pageSize at a time.  The `Limit` and `Offset` of opts are overridden by
+// the iterator.
+func (cs *MaintenanceService) ListAll(ctx context.Context, pageSize int, opts ...ListMaintenanceOptions) *Iterator[MaintenanceResponse] {
+	var o ListMaintenanceOptions
+	if len(opts) != 0 {
+		o = opts[0]
+	}
+	return newIterator(ctx, pageSize, func(ctx context.Context, limit, offset int) ([]MaintenanceResponse, error) {
+		o.Limit = limit
+		o.Offset = offset
+		return cs.ListContext(ctx, o)
+	})
+}
+
 // Read returns a Maintenance for a given ID.
 func (cs *MaintenanceService) Read(id int) (*MaintenanceResponse, error) {
-	req, err := cs.client.NewRequest("GET", "/maintenance/"+strconv.Itoa(id), nil)
+	return cs.ReadContext(context.Background(), id)
+}
+
+// ReadContext is like Read but uses the given context for the request.
+func (cs *MaintenanceService) ReadContext(ctx context.Context, id int) (*MaintenanceResponse, error) {
+	req, err := cs.client.NewRequestWithContext(ctx, "GET", "/maintenance/"+strconv.Itoa(id), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -76,11 +100,16 @@ func (cs *MaintenanceService) Read(id int) (*MaintenanceResponse, error) {
 
 // Create creates a new Maintenance.
 func (cs *MaintenanceService) Create(maintenance Maintenance) (*MaintenanceResponse, error) {
+	return cs.CreateContext(context.Background(), maintenance)
+}
+
+// CreateContext is like Create but uses the given context for the request.
+func (cs *MaintenanceService) CreateContext(ctx context.Context, maintenance Maintenance) (*MaintenanceResponse, error) {
 	if err := maintenance.Valid(); err != nil {
 		return nil, err
 	}
 
-	req, err := cs.client.NewRequest("POST", "/maintenance", maintenance.PostParams())
+	req, err := cs.newMaintenanceRequest(ctx, "POST", "/maintenance", maintenance, maintenance.PostParams)
 	if err != nil {
 		return nil, err
 	}
@@ -96,11 +125,16 @@ func (cs *MaintenanceService) Create(maintenance Maintenance) (*MaintenanceRespo
 // Update is used to update an existing Maintenance. Only the 'Description',
 // and 'To' fields can be updated.
 func (cs *MaintenanceService) Update(id int, maintenance Maintenance) (*PingdomResponse, error) {
+	return cs.UpdateContext(context.Background(), id, maintenance)
+}
+
+// UpdateContext is like Update but uses the given context for the request.
+func (cs *MaintenanceService) UpdateContext(ctx context.Context, id int, maintenance Maintenance) (*PingdomResponse, error) {
 	if err := maintenance.Valid(); err != nil {
 		return nil, err
 	}
 
-	req, err := cs.client.NewRequest("PUT", "/maintenance/"+strconv.Itoa(id), maintenance.PutParams())
+	req, err := cs.newMaintenanceRequest(ctx, "PUT", "/maintenance/"+strconv.Itoa(id), maintenance, maintenance.PutParams)
 	if err != nil {
 		return nil, err
 	}
@@ -113,13 +147,20 @@ func (cs *MaintenanceService) Update(id int, maintenance Maintenance) (*PingdomR
 	return m, err
 }
 
-// MultiDelete will delete the Maintenance for the given ID.
-func (cs *MaintenanceService) MultiDelete(maintenance MaintenanceDelete) (*PingdomResponse, error) {
-	if err := maintenance.ValidDelete(); err != nil {
-		return nil, err
+// MultiDelete will delete the Maintenance windows with the given IDs.
+func (cs *MaintenanceService) MultiDelete(ids []int) (*PingdomResponse, error) {
+	return cs.MultiDeleteContext(context.Background(), ids)
+}
+
+// MultiDeleteContext is like MultiDelete but uses the given context for the request.
+func (cs *MaintenanceService) MultiDeleteContext(ctx context.Context, ids []int) (*PingdomResponse, error) {
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("Invalid value for `ids`.  Must contain at least one ID")
 	}
 
-	req, err := cs.client.NewRequest("DELETE", "/maintenance/", maintenance.DeleteParams())
+	req, err := cs.client.NewRequestWithContext(ctx, "DELETE", "/maintenance", map[string]string{
+		"maintenanceids": intListToCDString(ids),
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -134,7 +175,12 @@ func (cs *MaintenanceService) MultiDelete(maintenance MaintenanceDelete) (*Pingd
 
 // Delete will delete the Maintenance for the given ID.
 func (cs *MaintenanceService) Delete(id int) (*PingdomResponse, error) {
-	req, err := cs.client.NewRequest("DELETE", "/maintenance/"+strconv.Itoa(id), nil)
+	return cs.DeleteContext(context.Background(), id)
+}
+
+// DeleteContext is like Delete but uses the given context for the request.
+func (cs *MaintenanceService) DeleteContext(ctx context.Context, id int) (*PingdomResponse, error) {
+	req, err := cs.client.NewRequestWithContext(ctx, "DELETE", "/maintenance/"+strconv.Itoa(id), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -146,3 +192,14 @@ func (cs *MaintenanceService) Delete(id int) (*PingdomResponse, error) {
 	}
 	return m, err
 }
+
+// newMaintenanceRequest returns a request sending the maintenance window as
+// a JSON body, which is not limited in length like a query string.
+// Maintenance implementations without a JSON form fall back to the query
+// parameters returned by params.
+func (cs *MaintenanceService) newMaintenanceRequest(ctx context.Context, method, rsc string, maintenance Maintenance, params func() map[string]string) (*http.Request, error) {
+	if m, ok := maintenance.(jsonMaintenance); ok {
+		return cs.client.NewJSONRequestWithContext(ctx, method, rsc, m.RenderForJSONAPI())
+	}
+	return cs.client.NewRequestWithContext(ctx, method, rsc, params())
+}
@@ -0,0 +1,271 @@
+package pingdom
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// CoverageOptions configure a MaintenanceCoverage.
+type CoverageOptions struct {
+	// Occurrences, as returned by OccurrenceService.List, are used instead
+	// of the local expansion for the maintenance windows they belong to.
+	// They must cover the periods analysed.
+	Occurrences []Occurrence
+	// Location is the timezone used to expand recurring windows and to
+	// split downtime by month.  UTC is used when it is nil.
+	Location *time.Location
+	// TMS analyses the transaction checks of the windows instead of their
+	// uptime checks.
+	TMS bool
+}
+
+// MaintenanceCoverage answers questions about which checks are covered by
+// a set of maintenance windows, and when.
+type MaintenanceCoverage struct {
+	maintenances []MaintenanceResponse
+	occurrences  map[int][]Occurrence
+	loc          *time.Location
+	tms          bool
+}
+
+// MaintenanceOverlap is a period during which two maintenance windows cover
+// the same checks.
+type MaintenanceOverlap struct {
+	MaintenanceIDs [2]int
+	From           time.Time
+	To             time.Time
+	CheckIDs       []int
+}
+
+// MonthlyDowntime is the planned downtime of a check during a month.
+type MonthlyDowntime struct {
+	// Month is the first instant of the month, in the location of the
+	// coverage.
+	Month    time.Time
+	Duration time.Duration
+}
+
+// Minutes returns the downtime in minutes.
+func (d MonthlyDowntime) Minutes() float64 {
+	return d.Duration.Minutes()
+}
+
+// interval is an occurrence of a maintenance window.
+type interval struct {
+	maintenanceID int
+	from, to      time.Time
+}
+
+// NewMaintenanceCoverage returns the coverage of the given maintenance
+// windows.  It fails if one of them cannot be expanded into occurrences.
+func NewMaintenanceCoverage(maintenances []MaintenanceResponse, opts CoverageOptions) (*MaintenanceCoverage, error) {
+	for _, m := range maintenances {
+		if err := Recurrence(m.RecurrenceType).Valid(); err != nil {
+			return nil, fmt.Errorf("maintenance %d: %v", m.ID, err)
+		}
+		if m.To <= m.From {
+			return nil, fmt.Errorf("maintenance %d: `To` must be after `From`", m.ID)
+		}
+	}
+
+	c := &MaintenanceCoverage{
+		maintenances: maintenances,
+		occurrences:  map[int][]Occurrence{},
+		loc:          opts.Location,
+		tms:          opts.TMS,
+	}
+	if c.loc == nil {
+		c.loc = time.UTC
+	}
+	for _, o := range opts.Occurrences {
+		id := int(o.MaintenanceId)
+		c.occurrences[id] = append(c.occurrences[id], o)
+	}
+
+	return c, nil
+}
+
+// InMaintenance reports whether the check is in maintenance at the given time.
+func (c *MaintenanceCoverage) InMaintenance(checkID int, at time.Time) bool {
+	return len(c.WindowsAt(checkID, at)) != 0
+}
+
+// WindowsAt returns the maintenance windows covering the check at the given time.
+func (c *MaintenanceCoverage) WindowsAt(checkID int, at time.Time) []MaintenanceResponse {
+	var windows []MaintenanceResponse
+	for i, m := range c.maintenances {
+		if !containsInt(c.checks(&m), checkID) {
+			continue
+		}
+		if len(c.intervals(&c.maintenances[i], at, at.Add(time.Second))) != 0 {
+			windows = append(windows, m)
+		}
+	}
+	return windows
+}
+
+// Overlaps returns the periods between from and to during which two
+// maintenance windows cover at least one common check.  Times are in the
+// location of the coverage.
+func (c *MaintenanceCoverage) Overlaps(from, to time.Time) []MaintenanceOverlap {
+	var overlaps []MaintenanceOverlap
+	for i := range c.maintenances {
+		a := &c.maintenances[i]
+		for j := i + 1; j < len(c.maintenances); j++ {
+			b := &c.maintenances[j]
+			shared := intersectInts(c.checks(a), c.checks(b))
+			if len(shared) == 0 {
+				continue
+			}
+			for _, ia := range c.intervals(a, from, to) {
+				for _, ib := range c.intervals(b, from, to) {
+					start, end := laterOf(ia.from, ib.from), earlierOf(ia.to, ib.to)
+					if start.Before(end) {
+						overlaps = append(overlaps, MaintenanceOverlap{
+							MaintenanceIDs: [2]int{a.ID, b.ID},
+							From:           start,
+							To:             end,
+							CheckIDs:       shared,
+						})
+					}
+				}
+			}
+		}
+	}
+
+	sort.SliceStable(overlaps, func(i, j int) bool { return overlaps[i].From.Before(overlaps[j].From) })
+	return overlaps
+}
+
+// Uncovered returns the checks among checkIDs that are not part of any
+// maintenance window.
+func (c *MaintenanceCoverage) Uncovered(checkIDs []int) []int {
+	covered := map[int]bool{}
+	for i := range c.maintenances {
+		for _, id := range c.checks(&c.maintenances[i]) {
+			covered[id] = true
+		}
+	}
+
+	var uncovered []int
+	for _, id := range checkIDs {
+		if !covered[id] {
+			uncovered = append(uncovered, id)
+		}
+	}
+	return uncovered
+}
+
+// Downtime returns, for each check, the planned downtime between from and
+// to split by month.  Time covered by several windows is only counted once.
+func (c *MaintenanceCoverage) Downtime(from, to time.Time) map[int][]MonthlyDowntime {
+	byCheck := map[int][]interval{}
+	for i := range c.maintenances {
+		m := &c.maintenances[i]
+		intervals := c.intervals(m, from, to)
+		for _, id := range c.checks(m) {
+			byCheck[id] = append(byCheck[id], intervals...)
+		}
+	}
+
+	downtime := map[int][]MonthlyDowntime{}
+	for id, intervals := range byCheck {
+		var months []MonthlyDowntime
+		for _, iv := range mergeIntervals(intervals) {
+			for start := iv.from; start.Before(iv.to); {
+				month := time.Date(start.In(c.loc).Year(), start.In(c.loc).Month(), 1, 0, 0, 0, 0, c.loc)
+				end := earlierOf(iv.to, month.AddDate(0, 1, 0))
+				if n := len(months); n != 0 && months[n-1].Month.Equal(month) {
+					months[n-1].Duration += end.Sub(start)
+				} else {
+					months = append(months, MonthlyDowntime{Month: month, Duration: end.Sub(start)})
+				}
+				start = end
+			}
+		}
+		if len(months) != 0 {
+			downtime[id] = months
+		}
+	}
+	return downtime
+}
+
+func (c *MaintenanceCoverage) checks(m *MaintenanceResponse) []int {
+	if c.tms {
+		return m.Checks.Tms
+	}
+	return m.Checks.Uptime
+}
+
+// intervals returns the occurrences of the window overlapping from and to,
+// clipped to that period.
+func (c *MaintenanceCoverage) intervals(m *MaintenanceResponse, from, to time.Time) []interval {
+	occurrences, ok := c.occurrences[m.ID]
+	if !ok {
+		// The window was checked by NewMaintenanceCoverage.
+		occurrences, _ = m.Occurrences(from, to, c.loc)
+	}
+
+	var intervals []interval
+	for _, o := range occurrences {
+		start, end := time.Unix(o.From, 0), time.Unix(o.To, 0)
+		if !overlaps(start, end, from, to) {
+			continue
+		}
+		intervals = append(intervals, interval{
+			maintenanceID: m.ID,
+			from:          laterOf(start, from).In(c.loc),
+			to:            earlierOf(end, to).In(c.loc),
+		})
+	}
+	return intervals
+}
+
+// mergeIntervals returns the union of the intervals, sorted.
+func mergeIntervals(intervals []interval) []interval {
+	sort.Slice(intervals, func(i, j int) bool { return intervals[i].from.Before(intervals[j].from) })
+
+	var merged []interval
+	for _, iv := range intervals {
+		if n := len(merged); n != 0 && !iv.from.After(merged[n-1].to) {
+			merged[n-1].to = laterOf(merged[n-1].to, iv.to)
+			continue
+		}
+		merged = append(merged, iv)
+	}
+	return merged
+}
+
+func laterOf(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+func earlierOf(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
+func containsInt(ints []int, n int) bool {
+	for _, i := range ints {
+		if i == n {
+			return true
+		}
+	}
+	return false
+}
+
+func intersectInts(a, b []int) []int {
+	var shared []int
+	for _, n := range a {
+		if containsInt(b, n) && !containsInt(shared, n) {
+			shared = append(shared, n)
+		}
+	}
+	return shared
+}
@@ -0,0 +1,114 @@
+package pingdom
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func coverageFixture(t *testing.T, opts CoverageOptions) *MaintenanceCoverage {
+	day := time.Date(2024, 1, 30, 0, 0, 0, 0, time.UTC)
+
+	c, err := NewMaintenanceCoverage([]MaintenanceResponse{
+		{
+			// Every day 22:00-02:00 from 30 January to 2 February.
+			ID:             1,
+			From:           day.Add(22 * time.Hour).Unix(),
+			To:             day.Add(26 * time.Hour).Unix(),
+			RecurrenceType: "day",
+			EffectiveTo:    day.AddDate(0, 0, 3).Add(26 * time.Hour).Unix(),
+			Checks:         MaintenanceCheckResponse{Uptime: []int{100, 200}},
+		},
+		{
+			// One-off 1 February 00:00-03:00.
+			ID:             2,
+			From:           day.AddDate(0, 0, 2).Unix(),
+			To:             day.AddDate(0, 0, 2).Add(3 * time.Hour).Unix(),
+			RecurrenceType: "none",
+			Checks:         MaintenanceCheckResponse{Uptime: []int{200, 300}, Tms: []int{7}},
+		},
+	}, opts)
+	assert.NoError(t, err)
+	return c
+}
+
+func TestMaintenanceCoverageInMaintenance(t *testing.T) {
+	c := coverageFixture(t, CoverageOptions{})
+
+	at := time.Date(2024, 1, 31, 23, 0, 0, 0, time.UTC)
+	assert.True(t, c.InMaintenance(100, at))
+	assert.False(t, c.InMaintenance(300, at))
+	assert.False(t, c.InMaintenance(100, at.Add(4*time.Hour)))
+
+	at = time.Date(2024, 2, 1, 1, 0, 0, 0, time.UTC)
+	windows := c.WindowsAt(200, at)
+	assert.Len(t, windows, 2)
+	assert.Equal(t, 1, windows[0].ID)
+	assert.Equal(t, 2, windows[1].ID)
+}
+
+func TestMaintenanceCoverageOverlaps(t *testing.T) {
+	c := coverageFixture(t, CoverageOptions{})
+
+	overlaps := c.Overlaps(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, []MaintenanceOverlap{
+		{
+			MaintenanceIDs: [2]int{1, 2},
+			From:           time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+			To:             time.Date(2024, 2, 1, 2, 0, 0, 0, time.UTC),
+			CheckIDs:       []int{200},
+		},
+	}, overlaps)
+}
+
+func TestMaintenanceCoverageUncovered(t *testing.T) {
+	c := coverageFixture(t, CoverageOptions{})
+	assert.Equal(t, []int{400, 500}, c.Uncovered([]int{100, 400, 300, 500}))
+
+	tms := coverageFixture(t, CoverageOptions{TMS: true})
+	assert.Equal(t, []int{8}, tms.Uncovered([]int{7, 8}))
+}
+
+func TestMaintenanceCoverageDowntime(t *testing.T) {
+	c := coverageFixture(t, CoverageOptions{})
+
+	downtime := c.Downtime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC))
+	january := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	february := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	// Four daily windows: 30 Jan 22:00 - 3 Feb 02:00, 2 hours of each in
+	// January for the first two.
+	assert.Equal(t, []MonthlyDowntime{
+		{Month: january, Duration: 6 * time.Hour},
+		{Month: february, Duration: 10 * time.Hour},
+	}, downtime[100])
+	// The one-off window adds one hour on 1 February, the overlap is only
+	// counted once.
+	assert.Equal(t, []MonthlyDowntime{
+		{Month: january, Duration: 6 * time.Hour},
+		{Month: february, Duration: 11 * time.Hour},
+	}, downtime[200])
+	assert.Equal(t, []MonthlyDowntime{{Month: february, Duration: 3 * time.Hour}}, downtime[300])
+	assert.Equal(t, float64(180), downtime[300][0].Minutes())
+	assert.NotContains(t, downtime, 400)
+}
+
+func TestMaintenanceCoverageWithOccurrences(t *testing.T) {
+	// The server moved the second occurrence of window 1 by one hour.
+	c := coverageFixture(t, CoverageOptions{Occurrences: []Occurrence{
+		{MaintenanceId: 1, From: time.Date(2024, 1, 30, 22, 0, 0, 0, time.UTC).Unix(), To: time.Date(2024, 1, 31, 2, 0, 0, 0, time.UTC).Unix()},
+		{MaintenanceId: 1, From: time.Date(2024, 1, 31, 23, 0, 0, 0, time.UTC).Unix(), To: time.Date(2024, 2, 1, 3, 0, 0, 0, time.UTC).Unix()},
+	}})
+
+	assert.False(t, c.InMaintenance(100, time.Date(2024, 1, 31, 22, 30, 0, 0, time.UTC)))
+	assert.True(t, c.InMaintenance(100, time.Date(2024, 1, 31, 23, 30, 0, 0, time.UTC)))
+}
+
+func TestNewMaintenanceCoverageInvalid(t *testing.T) {
+	_, err := NewMaintenanceCoverage([]MaintenanceResponse{{ID: 1, From: 1, To: 2, RecurrenceType: "year"}}, CoverageOptions{})
+	assert.Error(t, err)
+
+	_, err = NewMaintenanceCoverage([]MaintenanceResponse{{ID: 1, From: 2, To: 1}}, CoverageOptions{})
+	assert.Error(t, err)
+}
@@ -0,0 +1,95 @@
+package pingdom
+
+import (
+	"fmt"
+	"time"
+)
+
+// Occurrences computes locally the occurrences of the maintenance window that
+// overlap the period between from and to, without calling the API.
+//
+// Recurring windows repeat at the same wall clock time in loc, so that a
+// window starting at 22:00 keeps starting at 22:00 across daylight saving
+// time changes.  loc should be the timezone of the Pingdom account; UTC is
+// used when it is nil.  Monthly windows are skipped in the months that do
+// not have their day, as in RFC 5545.  Occurrences starting after
+// EffectiveTo are not returned.
+//
+// Only the MaintenanceId, From and To fields of the returned occurrences
+// are set.
+func (m *MaintenanceResponse) Occurrences(from, to time.Time, loc *time.Location) ([]Occurrence, error) {
+	recurrence := Recurrence(m.RecurrenceType)
+	if err := recurrence.Valid(); err != nil {
+		return nil, err
+	}
+
+	if m.To <= m.From {
+		return nil, fmt.Errorf("invalid maintenance window %d, `To` must be after `From`", m.ID)
+	}
+
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	start := time.Unix(m.From, 0).In(loc)
+	duration := time.Duration(m.To-m.From) * time.Second
+
+	if !recurrence.Recurring() {
+		if !overlaps(start, start.Add(duration), from, to) {
+			return nil, nil
+		}
+		return []Occurrence{m.occurrence(start, duration)}, nil
+	}
+
+	every := m.RepeatEvery
+	if every <= 0 {
+		every = 1
+	}
+
+	var end time.Time
+	if m.EffectiveTo != 0 {
+		end = time.Unix(m.EffectiveTo, 0)
+	}
+
+	var occurrences []Occurrence
+	for k := 0; ; k++ {
+		s, ok := nthOccurrenceStart(start, recurrence, k*every)
+		if !s.Before(to) || (!end.IsZero() && s.After(end)) {
+			break
+		}
+		if !ok {
+			continue
+		}
+		if overlaps(s, s.Add(duration), from, to) {
+			occurrences = append(occurrences, m.occurrence(s, duration))
+		}
+	}
+
+	return occurrences, nil
+}
+
+// nthOccurrenceStart returns the start of the occurrence n periods after
+// start, and false when it does not exist because the month is too short.
+func nthOccurrenceStart(start time.Time, recurrence Recurrence, n int) (time.Time, bool) {
+	switch recurrence {
+	case RecurrenceDay:
+		return start.AddDate(0, 0, n), true
+	case RecurrenceWeek:
+		return start.AddDate(0, 0, 7*n), true
+	default:
+		s := start.AddDate(0, n, 0)
+		return s, s.Day() == start.Day()
+	}
+}
+
+func (m *MaintenanceResponse) occurrence(start time.Time, duration time.Duration) Occurrence {
+	return Occurrence{
+		MaintenanceId: int64(m.ID),
+		From:          start.Unix(),
+		To:            start.Add(duration).Unix(),
+	}
+}
+
+func overlaps(start, end, from, to time.Time) bool {
+	return start.Before(to) && end.After(from)
+}
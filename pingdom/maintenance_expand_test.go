@@ -0,0 +1,119 @@
+package pingdom
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func occurrenceTimes(occurrences []Occurrence, loc *time.Location) []string {
+	var times []string
+	for _, o := range occurrences {
+		times = append(times, time.Unix(o.From, 0).In(loc).Format(time.RFC3339)+" "+time.Unix(o.To, 0).In(loc).Format("15:04"))
+	}
+	return times
+}
+
+func TestMaintenanceResponseOccurrencesNone(t *testing.T) {
+	from := time.Date(2024, 6, 1, 22, 0, 0, 0, time.UTC)
+	m := MaintenanceResponse{ID: 7, From: from.Unix(), To: from.Add(time.Hour).Unix(), RecurrenceType: "none"}
+
+	occurrences, err := m.Occurrences(from.AddDate(0, 0, -1), from.AddDate(0, 0, 1), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []Occurrence{{MaintenanceId: 7, From: from.Unix(), To: from.Add(time.Hour).Unix()}}, occurrences)
+
+	occurrences, err = m.Occurrences(from.AddDate(0, 0, 1), from.AddDate(0, 0, 2), nil)
+	assert.NoError(t, err)
+	assert.Empty(t, occurrences)
+}
+
+func TestMaintenanceResponseOccurrencesDaily(t *testing.T) {
+	// Matches the occurrences created by the acceptance test: a daily
+	// window with EffectiveTo three days after the first one ends.
+	from := time.Date(2024, 6, 1, 22, 0, 0, 0, time.UTC)
+	to := from.Add(time.Hour)
+	m := MaintenanceResponse{
+		ID:             7,
+		From:           from.Unix(),
+		To:             to.Unix(),
+		RecurrenceType: "day",
+		RepeatEvery:    1,
+		EffectiveTo:    to.Add(3 * 24 * time.Hour).Unix(),
+	}
+
+	occurrences, err := m.Occurrences(from.AddDate(0, 0, -7), from.AddDate(0, 1, 0), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"2024-06-01T22:00:00Z 23:00",
+		"2024-06-02T22:00:00Z 23:00",
+		"2024-06-03T22:00:00Z 23:00",
+		"2024-06-04T22:00:00Z 23:00",
+	}, occurrenceTimes(occurrences, time.UTC))
+
+	// Only the occurrences overlapping the period are returned.
+	occurrences, err = m.Occurrences(from.Add(24*time.Hour+30*time.Minute), from.Add(48*time.Hour), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"2024-06-02T22:00:00Z 23:00"}, occurrenceTimes(occurrences, time.UTC))
+}
+
+func TestMaintenanceResponseOccurrencesWeeklyDST(t *testing.T) {
+	stockholm, err := time.LoadLocation("Europe/Stockholm")
+	assert.NoError(t, err)
+
+	// Every second week at 22:00 Stockholm time, across the switch to
+	// summer time on 31 March 2024.
+	from := time.Date(2024, 3, 16, 22, 0, 0, 0, stockholm)
+	m := MaintenanceResponse{
+		From:           from.Unix(),
+		To:             from.Add(2 * time.Hour).Unix(),
+		RecurrenceType: "week",
+		RepeatEvery:    2,
+	}
+
+	occurrences, err := m.Occurrences(from, from.AddDate(0, 0, 43), stockholm)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"2024-03-16T22:00:00+01:00 00:00",
+		"2024-03-30T22:00:00+01:00 00:00",
+		"2024-04-13T22:00:00+02:00 00:00",
+		"2024-04-27T22:00:00+02:00 00:00",
+	}, occurrenceTimes(occurrences, stockholm))
+
+	// In UTC, the same window does not follow the daylight saving time.
+	occurrences, err = m.Occurrences(from, from.AddDate(0, 0, 43), time.UTC)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"2024-03-16T21:00:00Z 23:00",
+		"2024-03-30T21:00:00Z 23:00",
+		"2024-04-13T21:00:00Z 23:00",
+		"2024-04-27T21:00:00Z 23:00",
+	}, occurrenceTimes(occurrences, time.UTC))
+}
+
+func TestMaintenanceResponseOccurrencesMonthly(t *testing.T) {
+	from := time.Date(2024, 1, 31, 1, 0, 0, 0, time.UTC)
+	m := MaintenanceResponse{
+		From:           from.Unix(),
+		To:             from.Add(time.Hour).Unix(),
+		RecurrenceType: "month",
+		EffectiveTo:    time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC).Unix(),
+	}
+
+	occurrences, err := m.Occurrences(from, from.AddDate(1, 0, 0), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"2024-01-31T01:00:00Z 02:00",
+		"2024-03-31T01:00:00Z 02:00",
+		"2024-05-31T01:00:00Z 02:00",
+	}, occurrenceTimes(occurrences, time.UTC))
+}
+
+func TestMaintenanceResponseOccurrencesInvalid(t *testing.T) {
+	_, err := (&MaintenanceResponse{From: 1, To: 2, RecurrenceType: "year"}).Occurrences(time.Unix(0, 0), time.Unix(10, 0), nil)
+	assert.Error(t, err)
+
+	_, err = (&MaintenanceResponse{From: 2, To: 2}).Occurrences(time.Unix(0, 0), time.Unix(10, 0), nil)
+	assert.Error(t, err)
+}
@@ -1,9 +1,8 @@
 package pingdom
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
-	"io/ioutil"
 	"strconv"
 )
 
@@ -12,33 +11,33 @@ type OccurrenceService struct {
 }
 
 func (os *OccurrenceService) List(query ListOccurrenceQuery) ([]Occurrence, error) {
+	return os.ListContext(context.Background(), query)
+}
+
+// ListContext is like List but uses the given context for the request.
+func (os *OccurrenceService) ListContext(ctx context.Context, query ListOccurrenceQuery) ([]Occurrence, error) {
 	params := query.toParams()
-	req, err := os.client.NewRequest("GET", "/maintenance.occurrences", params)
+	req, err := os.client.NewRequestWithContext(ctx, "GET", "/maintenance.occurrences", params)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := os.client.client.Do(req)
+	m := &listOccurrenceResponse{}
+	_, err = os.client.Do(req, m)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	if err := validateResponse(resp); err != nil {
-		return nil, err
-	}
-
-	bodyBytes, _ := ioutil.ReadAll(resp.Body)
-	bodyString := string(bodyBytes)
-
-	m := &listOccurrenceResponse{}
-	err = json.Unmarshal([]byte(bodyString), &m)
 
 	return m.Occurrences, err
 }
 
 func (os *OccurrenceService) Read(id int64) (*Occurrence, error) {
-	req, err := os.client.NewRequest("GET", "/maintenance.occurrences/"+strconv.FormatInt(id, 10), nil)
+	return os.ReadContext(context.Background(), id)
+}
+
+// ReadContext is like Read but uses the given context for the request.
+func (os *OccurrenceService) ReadContext(ctx context.Context, id int64) (*Occurrence, error) {
+	req, err := os.client.NewRequestWithContext(ctx, "GET", "/maintenance.occurrences/"+strconv.FormatInt(id, 10), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -55,11 +54,16 @@ func (os *OccurrenceService) Read(id int64) (*Occurrence, error) {
 // Update is used to update an existing Occurrence. Only the 'From',
 // and 'To' fields can be updated.
 func (os *OccurrenceService) Update(id int64, occurrence Occurrence) (*PingdomResponse, error) {
+	return os.UpdateContext(context.Background(), id, occurrence)
+}
+
+// UpdateContext is like Update but uses the given context for the request.
+func (os *OccurrenceService) UpdateContext(ctx context.Context, id int64, occurrence Occurrence) (*PingdomResponse, error) {
 	if err := occurrence.Valid(); err != nil {
 		return nil, err
 	}
 
-	req, err := os.client.NewJSONRequest("PUT", "/maintenance.occurrences/"+strconv.FormatInt(id, 10), occurrence.RenderForJSONAPI())
+	req, err := os.client.NewJSONRequestWithContext(ctx, "PUT", "/maintenance.occurrences/"+strconv.FormatInt(id, 10), occurrence.RenderForJSONAPI())
 	if err != nil {
 		return nil, err
 	}
@@ -74,6 +78,11 @@ func (os *OccurrenceService) Update(id int64, occurrence Occurrence) (*PingdomRe
 
 // MultiDelete will delete the Occurrence for the given ID.
 func (os *OccurrenceService) MultiDelete(ids []int64) (*PingdomResponse, error) {
+	return os.MultiDeleteContext(context.Background(), ids)
+}
+
+// MultiDeleteContext is like MultiDelete but uses the given context for the request.
+func (os *OccurrenceService) MultiDeleteContext(ctx context.Context, ids []int64) (*PingdomResponse, error) {
 	if len(ids) == 0 {
 		return nil, fmt.Errorf("empty id list for multiple occurrence delete")
 	}
@@ -81,7 +90,7 @@ func (os *OccurrenceService) MultiDelete(ids []int64) (*PingdomResponse, error)
 	for _, id := range ids {
 		strIds = append(strIds, strconv.FormatInt(id, 10))
 	}
-	req, err := os.client.NewRequestMultiParamValue("DELETE", "/maintenance.occurrences", map[string][]string{
+	req, err := os.client.NewRequestMultiParamValueWithContext(ctx, "DELETE", "/maintenance.occurrences", map[string][]string{
 		"occurrenceids": strIds,
 	})
 	if err != nil {
@@ -98,7 +107,12 @@ func (os *OccurrenceService) MultiDelete(ids []int64) (*PingdomResponse, error)
 
 // Delete will delete the Occurrence for the given ID.
 func (os *OccurrenceService) Delete(id int64) (*PingdomResponse, error) {
-	req, err := os.client.NewRequest("DELETE", "/maintenance.occurrences/"+strconv.FormatInt(id, 10), nil)
+	return os.DeleteContext(context.Background(), id)
+}
+
+// DeleteContext is like Delete but uses the given context for the request.
+func (os *OccurrenceService) DeleteContext(ctx context.Context, id int64) (*PingdomResponse, error) {
+	req, err := os.client.NewRequestWithContext(ctx, "DELETE", "/maintenance.occurrences/"+strconv.FormatInt(id, 10), nil)
 	if err != nil {
 		return nil, err
 	}
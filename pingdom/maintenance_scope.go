@@ -0,0 +1,158 @@
+package pingdom
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ActiveMaintenance is a maintenance window started by
+// MaintenanceService.Begin.  It lasts until End is called, the context
+// given to Begin is cancelled, or its maximum duration elapses, whichever
+// comes first.
+type ActiveMaintenance struct {
+	// ID is the ID of the maintenance window.
+	ID int
+
+	service *MaintenanceService
+	window  MaintenanceWindow
+	stop    chan struct{}
+	done    chan struct{}
+	once    sync.Once
+	err     error
+	// endTimeout bounds the requests ending the window on cancellation.
+	endTimeout time.Duration
+}
+
+// defaultEndTimeout bounds the requests ending a window on cancellation
+// when the HTTP client has no timeout.
+const defaultEndTimeout = 30 * time.Second
+
+// Begin starts a maintenance window for the given uptime and transaction
+// checks, lasting at most maxDuration.  The window is ended early by
+// calling End on the returned ActiveMaintenance, and automatically when
+// ctx is cancelled:
+//
+//	m, err := client.Maintenances.Begin(ctx, []int{12345}, nil, 30*time.Minute, "Deploy")
+//	if err != nil {
+//		...
+//	}
+//	defer m.End()
+func (cs *MaintenanceService) Begin(ctx context.Context, checkIDs, tmsIDs []int, maxDuration time.Duration, description string) (*ActiveMaintenance, error) {
+	if len(checkIDs) == 0 && len(tmsIDs) == 0 {
+		return nil, fmt.Errorf("invalid value for `checkIDs` and `tmsIDs`, at least one check is required")
+	}
+
+	if maxDuration < time.Second {
+		return nil, fmt.Errorf("invalid value %v for `maxDuration`, must be at least one second", maxDuration)
+	}
+
+	from := time.Now()
+	window := MaintenanceWindow{
+		Description: description,
+		From:        from.Unix(),
+		To:          from.Add(maxDuration).Unix(),
+	}
+
+	if len(checkIDs) != 0 {
+		window.UptimeIDs = intListToCDString(checkIDs)
+	}
+
+	if len(tmsIDs) != 0 {
+		window.TmsIDs = intListToCDString(tmsIDs)
+	}
+
+	m, err := cs.CreateContext(ctx, &window)
+	if err != nil {
+		return nil, err
+	}
+
+	am := &ActiveMaintenance{
+		ID:      m.ID,
+		service: cs,
+		window:  window,
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+
+		endTimeout: defaultEndTimeout,
+	}
+	// Ending may take an update and a delete request.
+	if timeout := cs.client.client.Timeout; timeout > 0 {
+		am.endTimeout = 2 * timeout
+	}
+	go am.watch(ctx, maxDuration)
+
+	return am, nil
+}
+
+// watch ends the window when ctx is cancelled, giving up after endTimeout
+// so that a hung request cannot block it; the error is reported by Err.
+// It returns without calling the API once the window has expired by itself.
+func (am *ActiveMaintenance) watch(ctx context.Context, maxDuration time.Duration) {
+	timer := time.NewTimer(maxDuration)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		endCtx, cancel := context.WithTimeout(context.Background(), am.endTimeout)
+		defer cancel()
+		am.EndContext(endCtx)
+	case <-timer.C:
+		am.once.Do(func() { close(am.done) })
+	case <-am.stop:
+	}
+}
+
+// End ends the maintenance window now, by setting its end to the current
+// time, or by deleting it when it cannot be updated.  Only the first call
+// has an effect, later calls return the same error.
+func (am *ActiveMaintenance) End() error {
+	return am.EndContext(context.Background())
+}
+
+// EndContext is like End but uses the given context for the requests.
+func (am *ActiveMaintenance) EndContext(ctx context.Context) error {
+	am.once.Do(func() {
+		close(am.stop)
+		am.err = am.end(ctx)
+		close(am.done)
+	})
+	<-am.done
+	return am.err
+}
+
+func (am *ActiveMaintenance) end(ctx context.Context) error {
+	window := am.window
+	window.To = time.Now().Unix()
+	if window.To <= window.From {
+		window.To = window.From + 1
+	}
+
+	_, err := am.service.UpdateContext(ctx, am.ID, &window)
+	if err == nil {
+		return nil
+	}
+
+	if _, derr := am.service.DeleteContext(ctx, am.ID); derr != nil {
+		return fmt.Errorf("ending maintenance %d: update: %v, delete: %w", am.ID, err, derr)
+	}
+	return nil
+}
+
+// Done returns a channel that is closed once the maintenance window has
+// ended.
+func (am *ActiveMaintenance) Done() <-chan struct{} {
+	return am.done
+}
+
+// Err returns the error of ending the maintenance window, once Done is
+// closed.
+func (am *ActiveMaintenance) Err() error {
+	select {
+	case <-am.done:
+		return am.err
+	default:
+		return nil
+	}
+}
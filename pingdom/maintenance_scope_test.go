@@ -0,0 +1,161 @@
+package pingdom
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type maintenanceBody struct {
+	Description string `json:"description"`
+	From        int64  `json:"from"`
+	To          int64  `json:"to"`
+	UptimeIDs   string `json:"uptimeids"`
+	TmsIDs      string `json:"tmsids"`
+}
+
+func decodeMaintenanceBody(t *testing.T, r *http.Request) maintenanceBody {
+	var body maintenanceBody
+	assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+	return body
+}
+
+func handleBeginMaintenance(t *testing.T) {
+	mux.HandleFunc("/maintenance", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		body := decodeMaintenanceBody(t, r)
+		assert.Equal(t, "Deploy", body.Description)
+		assert.Equal(t, "12345,67890", body.UptimeIDs)
+		assert.Equal(t, "9876", body.TmsIDs)
+		assert.Equal(t, int64(30*60), body.To-body.From)
+
+		fmt.Fprint(w, `{"maintenance": {"id": 42}}`)
+	})
+}
+
+func TestMaintenanceServiceBeginEnd(t *testing.T) {
+	setup()
+	defer teardown()
+	handleBeginMaintenance(t)
+
+	var updates int32
+	mux.HandleFunc("/maintenance/42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		atomic.AddInt32(&updates, 1)
+
+		body := decodeMaintenanceBody(t, r)
+		assert.True(t, body.To > body.From)
+		assert.True(t, body.To <= time.Now().Unix()+1)
+		assert.Equal(t, "12345,67890", body.UptimeIDs)
+
+		fmt.Fprint(w, `{"message":"Maintenance window successfully modified!"}`)
+	})
+
+	m, err := client.Maintenances.Begin(context.Background(), []int{12345, 67890}, []int{9876}, 30*time.Minute, "Deploy")
+	assert.NoError(t, err)
+	assert.Equal(t, 42, m.ID)
+
+	assert.NoError(t, m.End())
+	assert.NoError(t, m.End())
+	assert.Equal(t, int32(1), atomic.LoadInt32(&updates))
+
+	select {
+	case <-m.Done():
+	default:
+		t.Error("Done should be closed after End")
+	}
+}
+
+func TestMaintenanceServiceBeginContextCancel(t *testing.T) {
+	setup()
+	defer teardown()
+	handleBeginMaintenance(t)
+
+	mux.HandleFunc("/maintenance/42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		fmt.Fprint(w, `{"message":"Maintenance window successfully modified!"}`)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	m, err := client.Maintenances.Begin(ctx, []int{12345, 67890}, []int{9876}, 30*time.Minute, "Deploy")
+	assert.NoError(t, err)
+
+	cancel()
+	select {
+	case <-m.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("maintenance was not ended on context cancellation")
+	}
+	assert.NoError(t, m.Err())
+}
+
+func TestMaintenanceServiceBeginContextCancelTimesOut(t *testing.T) {
+	setup()
+	defer teardown()
+	handleBeginMaintenance(t)
+
+	release := make(chan struct{})
+	defer close(release)
+	mux.HandleFunc("/maintenance/42", func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	m, err := client.Maintenances.Begin(ctx, []int{12345, 67890}, []int{9876}, 30*time.Minute, "Deploy")
+	assert.NoError(t, err)
+	m.endTimeout = 50 * time.Millisecond
+
+	cancel()
+	select {
+	case <-m.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("ending the maintenance was not bounded by its timeout")
+	}
+	assert.True(t, errors.Is(m.Err(), context.DeadlineExceeded))
+}
+
+func TestMaintenanceServiceBeginEndFallsBackToDelete(t *testing.T) {
+	setup()
+	defer teardown()
+	handleBeginMaintenance(t)
+
+	var deletes int32
+	mux.HandleFunc("/maintenance/42", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "PUT":
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"error":{"statuscode":400,"statusdesc":"Bad Request","errormessage":"Invalid parameter value: to"}}`)
+		case "DELETE":
+			atomic.AddInt32(&deletes, 1)
+			fmt.Fprint(w, `{"message":"Maintenance window successfully deleted!"}`)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	m, err := client.Maintenances.Begin(context.Background(), []int{12345, 67890}, []int{9876}, 30*time.Minute, "Deploy")
+	assert.NoError(t, err)
+	assert.NoError(t, m.End())
+	assert.Equal(t, int32(1), atomic.LoadInt32(&deletes))
+}
+
+func TestMaintenanceServiceBeginInvalid(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.Maintenances.Begin(context.Background(), nil, nil, time.Minute, "Deploy")
+	assert.Error(t, err)
+
+	_, err = client.Maintenances.Begin(context.Background(), []int{1}, nil, 0, "Deploy")
+	assert.Error(t, err)
+
+	_, err = client.Maintenances.Begin(context.Background(), []int{1}, nil, time.Minute, "")
+	assert.Error(t, err)
+}
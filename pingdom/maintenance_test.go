@@ -2,6 +2,7 @@ package pingdom
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"testing"
 
@@ -164,3 +165,125 @@ func TestMaintenanceServiceDelete(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, want, msg, "Maintenances.Delete() should return correct result")
 }
+
+func TestMaintenanceServiceListWithOptions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/maintenance", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, "10", r.URL.Query().Get("limit"))
+		assert.Equal(t, "from", r.URL.Query().Get("orderby"))
+		assert.Equal(t, "desc", r.URL.Query().Get("order"))
+		fmt.Fprint(w, `{"maintenance": [{"id": 85975}]}`)
+	})
+
+	maintenances, err := client.Maintenances.List(ListMaintenanceOptions{Limit: 10, OrderBy: "from", Order: "desc"})
+	assert.NoError(t, err)
+	assert.Equal(t, []MaintenanceResponse{{ID: 85975}}, maintenances)
+
+	_, err = client.Maintenances.List(ListMaintenanceOptions{OrderBy: "checks"})
+	assert.Error(t, err)
+}
+
+func TestMaintenanceServiceCreateJSON(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/maintenance", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Empty(t, r.URL.RawQuery)
+
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{
+			"description": "Maintenance N",
+			"from": 1,
+			"to": 1524048059,
+			"recurrencetype": "day",
+			"repeatevery": 2,
+			"uptimeids": "12345,67890"
+		}`, string(body))
+
+		fmt.Fprint(w, `{"maintenance": {"id": 85975}}`)
+	})
+
+	m := MaintenanceWindow{
+		Description:    "Maintenance N",
+		From:           1,
+		To:             1524048059,
+		RecurrenceType: "day",
+		RepeatEvery:    2,
+		UptimeIDs:      "12345,67890",
+	}
+
+	maintenance, err := client.Maintenances.Create(&m)
+	assert.NoError(t, err)
+	assert.Equal(t, &MaintenanceResponse{ID: 85975}, maintenance)
+}
+
+func TestMaintenanceServiceUpdateJSON(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/maintenance/12345", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"description": "Updated Maintenance N", "from": 1, "to": 1524048061}`, string(body))
+
+		fmt.Fprint(w, `{"message":"Maintenance window successfully modified!"}`)
+	})
+
+	_, err := client.Maintenances.Update(12345, &MaintenanceWindow{Description: "Updated Maintenance N", From: 1, To: 1524048061})
+	assert.NoError(t, err)
+}
+
+// queryMaintenance is a Maintenance without a JSON form.
+type queryMaintenance struct{}
+
+func (queryMaintenance) PutParams() map[string]string {
+	return map[string]string{"description": "query", "from": "1", "to": "2"}
+}
+
+func (q queryMaintenance) PostParams() map[string]string {
+	return q.PutParams()
+}
+
+func (queryMaintenance) Valid() error {
+	return nil
+}
+
+func TestMaintenanceServiceCreateQueryFallback(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/maintenance", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		assert.NotEqual(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Equal(t, "query", r.URL.Query().Get("description"))
+		fmt.Fprint(w, `{"maintenance": {"id": 85975}}`)
+	})
+
+	_, err := client.Maintenances.Create(queryMaintenance{})
+	assert.NoError(t, err)
+}
+
+func TestMaintenanceServiceMultiDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/maintenance", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		assert.Equal(t, "12345,67890", r.URL.Query().Get("maintenanceids"))
+		fmt.Fprint(w, `{"message":"2 maintenance windows successfully deleted."}`)
+	})
+
+	msg, err := client.Maintenances.MultiDelete([]int{12345, 67890})
+	assert.NoError(t, err)
+	assert.Equal(t, &PingdomResponse{Message: "2 maintenance windows successfully deleted."}, msg)
+
+	_, err = client.Maintenances.MultiDelete(nil)
+	assert.Error(t, err)
+}
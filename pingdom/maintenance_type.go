@@ -1,10 +1,38 @@
 package pingdom
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
+	"time"
 )
 
+// Recurrence is how a maintenance window repeats.
+type Recurrence string
+
+// Recurrences supported by Pingdom.
+const (
+	RecurrenceNone  Recurrence = "none"
+	RecurrenceDay   Recurrence = "day"
+	RecurrenceWeek  Recurrence = "week"
+	RecurrenceMonth Recurrence = "month"
+)
+
+// Valid determines whether the Recurrence is supported by Pingdom.  The
+// empty Recurrence is the same as RecurrenceNone.
+func (r Recurrence) Valid() error {
+	switch r {
+	case "", RecurrenceNone, RecurrenceDay, RecurrenceWeek, RecurrenceMonth:
+		return nil
+	}
+	return fmt.Errorf("Invalid value %q for recurrence.  Allowed values are none, day, week, month", string(r))
+}
+
+// Recurring reports whether the Recurrence repeats.
+func (r Recurrence) Recurring() bool {
+	return r != "" && r != RecurrenceNone
+}
+
 // MaintenanceWindow represents a Pingdom Maintenance Window.
 type MaintenanceWindow struct {
 	Description    string `json:"description"`
@@ -18,6 +46,8 @@ type MaintenanceWindow struct {
 }
 
 // MaintenanceWindowDelete represents delete request parameters.
+//
+// Deprecated: MultiDelete takes the IDs of the maintenance windows.
 type MaintenanceWindowDelete struct {
 	MaintenanceIDs string `json:"maintenanceids"`
 }
@@ -69,6 +99,40 @@ func (ck *MaintenanceWindow) PostParams() map[string]string {
 	return params
 }
 
+// RenderForJSONAPI returns the JSON formatted version of this MaintenanceWindow.
+// It holds the same values as PostParams.
+func (ck *MaintenanceWindow) RenderForJSONAPI() string {
+	b := map[string]interface{}{
+		"description": ck.Description,
+		"from":        ck.From,
+		"to":          ck.To,
+	}
+
+	// Ignore if not defined
+	if ck.RecurrenceType != "" {
+		b["recurrencetype"] = ck.RecurrenceType
+	}
+
+	if ck.UptimeIDs != "" {
+		b["uptimeids"] = ck.UptimeIDs
+	}
+
+	if ck.TmsIDs != "" {
+		b["tmsids"] = ck.TmsIDs
+	}
+
+	if ck.RepeatEvery != 0 {
+		b["repeatevery"] = ck.RepeatEvery
+	}
+
+	if ck.EffectiveTo != 0 {
+		b["effectiveto"] = ck.EffectiveTo
+	}
+
+	jsonBody, _ := json.Marshal(b)
+	return string(jsonBody)
+}
+
 // Valid determines whether the MaintenanceWindow contains valid fields.  This can be
 // used to guard against sending illegal values to the Pingdom API.
 func (ck *MaintenanceWindow) Valid() error {
@@ -84,7 +148,7 @@ func (ck *MaintenanceWindow) Valid() error {
 		return fmt.Errorf("Invalid value for `To`.  Must contain time")
 	}
 
-	return nil
+	return Recurrence(ck.RecurrenceType).Valid()
 }
 
 // DeleteParams returns a map of parameters for an MaintenanceWindow that can be sent along.
@@ -104,3 +168,166 @@ func (ck *MaintenanceWindowDelete) ValidDelete() error {
 
 	return nil
 }
+
+// MaintenanceSchedule is a typed MaintenanceWindow.  It is sent to Pingdom
+// with the same parameters as the equivalent MaintenanceWindow, and can be
+// used wherever a Maintenance is expected.
+type MaintenanceSchedule struct {
+	Description string
+	From        time.Time
+	To          time.Time
+	Recurrence  Recurrence
+	// RepeatEvery repeats the window every n-th day, week or month.
+	RepeatEvery int
+	// EffectiveTo is the end of the recurrence.
+	EffectiveTo time.Time
+	UptimeIDs   []int
+	TmsIDs      []int
+}
+
+// Window returns the MaintenanceWindow equivalent to the MaintenanceSchedule.
+func (ms *MaintenanceSchedule) Window() *MaintenanceWindow {
+	w := &MaintenanceWindow{
+		Description:    ms.Description,
+		From:           unixOrZero(ms.From),
+		To:             unixOrZero(ms.To),
+		RecurrenceType: string(ms.Recurrence),
+		RepeatEvery:    ms.RepeatEvery,
+		EffectiveTo:    unixOrZero(ms.EffectiveTo),
+	}
+
+	if len(ms.UptimeIDs) != 0 {
+		w.UptimeIDs = intListToCDString(ms.UptimeIDs)
+	}
+
+	if len(ms.TmsIDs) != 0 {
+		w.TmsIDs = intListToCDString(ms.TmsIDs)
+	}
+
+	return w
+}
+
+// PutParams returns a map of parameters for a MaintenanceSchedule that can be sent along.
+func (ms *MaintenanceSchedule) PutParams() map[string]string {
+	return ms.Window().PutParams()
+}
+
+// PostParams returns a map of parameters for a MaintenanceSchedule that can be sent along
+// with an HTTP POST request.
+func (ms *MaintenanceSchedule) PostParams() map[string]string {
+	return ms.Window().PostParams()
+}
+
+// RenderForJSONAPI returns the JSON formatted version of this MaintenanceSchedule.
+func (ms *MaintenanceSchedule) RenderForJSONAPI() string {
+	return ms.Window().RenderForJSONAPI()
+}
+
+// Valid determines whether the MaintenanceSchedule contains valid fields.
+// Unlike MaintenanceWindow, it also requires the window to end after it
+// starts and the recurrence settings to be consistent.
+func (ms *MaintenanceSchedule) Valid() error {
+	if ms.Description == "" {
+		return fmt.Errorf("Invalid value for `Description`.  Must contain non-empty string")
+	}
+
+	if ms.From.IsZero() {
+		return fmt.Errorf("Invalid value for `From`.  Must contain time")
+	}
+
+	if ms.To.IsZero() {
+		return fmt.Errorf("Invalid value for `To`.  Must contain time")
+	}
+
+	if !ms.To.After(ms.From) {
+		return fmt.Errorf("Invalid value for `To`.  Must be after `From`")
+	}
+
+	if err := ms.Recurrence.Valid(); err != nil {
+		return err
+	}
+
+	if ms.RepeatEvery < 0 {
+		return fmt.Errorf("Invalid value %v for `RepeatEvery`.  Must not be negative", ms.RepeatEvery)
+	}
+
+	if !ms.Recurrence.Recurring() {
+		if ms.RepeatEvery != 0 {
+			return fmt.Errorf("Invalid value %v for `RepeatEvery`.  Must not be set without a recurrence", ms.RepeatEvery)
+		}
+		if !ms.EffectiveTo.IsZero() && !ms.EffectiveTo.Equal(ms.To) {
+			return fmt.Errorf("Invalid value for `EffectiveTo`.  Must not be set without a recurrence")
+		}
+		return nil
+	}
+
+	if !ms.EffectiveTo.IsZero() && ms.EffectiveTo.Before(ms.To) {
+		return fmt.Errorf("Invalid value for `EffectiveTo`.  Must not be before `To`")
+	}
+
+	return nil
+}
+
+func unixOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
+
+// ListMaintenanceOptions are the parameters of a Pingdom maintenance list
+// request.  Zero values are not sent.
+type ListMaintenanceOptions struct {
+	Limit  int
+	Offset int
+	// OrderBy is one of description, from, to or id.
+	OrderBy string
+	// Order is asc or desc.
+	Order string
+}
+
+// Valid determines whether a ListMaintenanceOptions contains valid fields for the Pingdom API.
+func (o ListMaintenanceOptions) Valid() error {
+	if o.Limit < 0 {
+		return fmt.Errorf("invalid value %v for `Limit`, must not be negative", o.Limit)
+	}
+
+	if o.Offset < 0 {
+		return fmt.Errorf("invalid value %v for `Offset`, must not be negative", o.Offset)
+	}
+
+	switch o.OrderBy {
+	case "", "description", "from", "to", "id":
+	default:
+		return fmt.Errorf("invalid value %q for `OrderBy`, allowed values are description, from, to, id", o.OrderBy)
+	}
+
+	if o.Order != "" && o.Order != "asc" && o.Order != "desc" {
+		return ErrBadOrder
+	}
+
+	return nil
+}
+
+// Params returns the query parameters of a ListMaintenanceOptions.
+func (o ListMaintenanceOptions) Params() map[string]string {
+	m := map[string]string{}
+
+	if o.Limit != 0 {
+		m["limit"] = strconv.Itoa(o.Limit)
+	}
+
+	if o.Offset != 0 {
+		m["offset"] = strconv.Itoa(o.Offset)
+	}
+
+	if o.OrderBy != "" {
+		m["orderby"] = o.OrderBy
+	}
+
+	if o.Order != "" {
+		m["order"] = o.Order
+	}
+
+	return m
+}
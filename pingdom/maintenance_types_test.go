@@ -2,6 +2,7 @@ package pingdom
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -70,3 +71,136 @@ func TestMaintenanceNotValid(t *testing.T) {
 
 	assert.NotEqual(t, nil, params, "Maintenance.Valid() should return not nil if not valid")
 }
+
+func TestMaintenanceValidRecurrence(t *testing.T) {
+	maintenance := MaintenanceWindow{
+		Description:    "fake maintenance",
+		From:           1,
+		To:             1524040922,
+		RecurrenceType: "fortnight",
+	}
+
+	assert.Error(t, maintenance.Valid())
+
+	maintenance.RecurrenceType = "week"
+	assert.NoError(t, maintenance.Valid())
+}
+
+func TestMaintenanceSchedulePutParams(t *testing.T) {
+	from := time.Unix(1600000000, 0)
+	schedule := MaintenanceSchedule{
+		Description: "fake maintenance",
+		From:        from,
+		To:          from.Add(time.Hour),
+		Recurrence:  RecurrenceWeek,
+		RepeatEvery: 2,
+		EffectiveTo: from.AddDate(0, 3, 0),
+		UptimeIDs:   []int{12345, 67890},
+		TmsIDs:      []int{9876},
+	}
+	window := MaintenanceWindow{
+		Description:    "fake maintenance",
+		From:           1600000000,
+		To:             1600003600,
+		RecurrenceType: "week",
+		RepeatEvery:    2,
+		EffectiveTo:    from.AddDate(0, 3, 0).Unix(),
+		UptimeIDs:      "12345,67890",
+		TmsIDs:         "9876",
+	}
+
+	assert.Equal(t, &window, schedule.Window())
+	assert.Equal(t, window.PutParams(), schedule.PutParams())
+	assert.Equal(t, window.PostParams(), schedule.PostParams())
+}
+
+func TestMaintenanceSchedulePostParamsOmitsEmpty(t *testing.T) {
+	from := time.Unix(1600000000, 0)
+	schedule := MaintenanceSchedule{Description: "fake maintenance", From: from, To: from.Add(time.Hour)}
+
+	assert.Equal(t, map[string]string{
+		"description": "fake maintenance",
+		"from":        "1600000000",
+		"to":          "1600003600",
+	}, schedule.PostParams())
+}
+
+func TestMaintenanceScheduleValid(t *testing.T) {
+	from := time.Unix(1600000000, 0)
+	to := from.Add(time.Hour)
+
+	tests := []struct {
+		name     string
+		schedule MaintenanceSchedule
+		valid    bool
+	}{
+		{"one-off", MaintenanceSchedule{Description: "m", From: from, To: to}, true},
+		{"one-off with effective to", MaintenanceSchedule{Description: "m", From: from, To: to, Recurrence: RecurrenceNone, EffectiveTo: to}, true},
+		{"daily", MaintenanceSchedule{Description: "m", From: from, To: to, Recurrence: RecurrenceDay, RepeatEvery: 1, EffectiveTo: to.AddDate(0, 0, 7)}, true},
+		{"monthly without end", MaintenanceSchedule{Description: "m", From: from, To: to, Recurrence: RecurrenceMonth}, true},
+		{"missing description", MaintenanceSchedule{From: from, To: to}, false},
+		{"missing from", MaintenanceSchedule{Description: "m", To: to}, false},
+		{"to before from", MaintenanceSchedule{Description: "m", From: to, To: from}, false},
+		{"to equal from", MaintenanceSchedule{Description: "m", From: from, To: from}, false},
+		{"unknown recurrence", MaintenanceSchedule{Description: "m", From: from, To: to, Recurrence: "year"}, false},
+		{"negative repeat", MaintenanceSchedule{Description: "m", From: from, To: to, Recurrence: RecurrenceDay, RepeatEvery: -1}, false},
+		{"repeat without recurrence", MaintenanceSchedule{Description: "m", From: from, To: to, RepeatEvery: 1}, false},
+		{"effective to without recurrence", MaintenanceSchedule{Description: "m", From: from, To: to, EffectiveTo: to.AddDate(0, 0, 7)}, false},
+		{"effective to before to", MaintenanceSchedule{Description: "m", From: from, To: to, Recurrence: RecurrenceWeek, EffectiveTo: from}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.schedule.Valid()
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMaintenanceRenderForJSONAPI(t *testing.T) {
+	maintenance := MaintenanceWindow{
+		Description:    "fake maintenance",
+		From:           1,
+		To:             1524040922,
+		RecurrenceType: "week",
+		RepeatEvery:    1,
+		EffectiveTo:    1534040922,
+		UptimeIDs:      "12345,67890",
+		TmsIDs:         "09876,54321",
+	}
+
+	assert.JSONEq(t, `{
+		"description": "fake maintenance",
+		"from": 1,
+		"to": 1524040922,
+		"recurrencetype": "week",
+		"repeatevery": 1,
+		"effectiveto": 1534040922,
+		"uptimeids": "12345,67890",
+		"tmsids": "09876,54321"
+	}`, maintenance.RenderForJSONAPI())
+
+	empty := MaintenanceWindow{Description: "fake maintenance", From: 1, To: 2}
+	assert.JSONEq(t, `{"description": "fake maintenance", "from": 1, "to": 2}`, empty.RenderForJSONAPI())
+}
+
+func TestListMaintenanceOptions(t *testing.T) {
+	assert.NoError(t, ListMaintenanceOptions{}.Valid())
+	assert.NoError(t, ListMaintenanceOptions{Limit: 10, OrderBy: "id", Order: "asc"}.Valid())
+	assert.Error(t, ListMaintenanceOptions{Limit: -1}.Valid())
+	assert.Error(t, ListMaintenanceOptions{Offset: -1}.Valid())
+	assert.Error(t, ListMaintenanceOptions{OrderBy: "name"}.Valid())
+	assert.Equal(t, ErrBadOrder, ListMaintenanceOptions{Order: "up"}.Valid())
+
+	assert.Equal(t, map[string]string{}, ListMaintenanceOptions{}.Params())
+	assert.Equal(t, map[string]string{
+		"limit":   "10",
+		"offset":  "20",
+		"orderby": "description",
+		"order":   "desc",
+	}, ListMaintenanceOptions{Limit: 10, Offset: 20, OrderBy: "description", Order: "desc"}.Params())
+}
@@ -1,6 +1,7 @@
 package pingdom
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -8,6 +9,7 @@ import (
 	"net/url"
 	"os"
 	"strings"
+	"time"
 )
 
 const (
@@ -20,11 +22,18 @@ type Client struct {
 	APITokenOnly  string 
 	BaseURL       *url.URL
 	client        *http.Client
+	retryPolicy   *RetryPolicy
+	limiter       *rateLimiter
+	Actions       *ActionsService
+	Analysis      *AnalysisService
 	Checks        *CheckService
 	Contacts      *ContactService
+	Credits       *CreditsService
+	Diagnostics   *DiagnosticsService
 	Maintenances  *MaintenanceService
 	Occurrences   *OccurrenceService
 	Probes        *ProbeService
+	Reference     *ReferenceService
 	Teams         *TeamService
 	TMSCheck      *TMSCheckService
 }
@@ -35,6 +44,16 @@ type ClientConfig struct {
 	APITokenOnly  string
 	BaseURL       string
 	HTTPClient    *http.Client
+	// RetryPolicy enables automatic retries of failed requests in Do.
+	// Retries are disabled when nil.
+	RetryPolicy *RetryPolicy
+	// Throttle makes Do wait for the rate limit window to reset, instead of
+	// sending a request that Pingdom would reject, once the Req-Limit-Short
+	// or Req-Limit-Long headers report that no requests remain.
+	Throttle bool
+	// OnRateLimit, when set, is called with the updated rate limit after
+	// every response carrying the Pingdom rate limit headers.
+	OnRateLimit func(RateLimit)
 }
 
 // NewClientWithConfig returns a Pingdom client.
@@ -83,16 +102,31 @@ func NewClientWithConfig(config ClientConfig) (*Client, error) {
 		c.client = http.DefaultClient
 	}
 
+	c.retryPolicy = config.RetryPolicy
+	c.limiter = &rateLimiter{throttle: config.Throttle, onUpdate: config.OnRateLimit}
+
+	c.Actions = &ActionsService{client: c}
+	c.Analysis = &AnalysisService{client: c}
 	c.Checks = &CheckService{client: c}
 	c.Contacts = &ContactService{client: c}
+	c.Credits = &CreditsService{client: c}
+	c.Diagnostics = &DiagnosticsService{client: c}
 	c.Maintenances = &MaintenanceService{client: c}
 	c.Occurrences = &OccurrenceService{client: c}
 	c.Probes = &ProbeService{client: c}
+	c.Reference = &ReferenceService{client: c}
 	c.Teams = &TeamService{client: c}
 	c.TMSCheck = &TMSCheckService{client: c}
 	return c, nil
 }
 
+// RateLimit returns the API budget reported by the last Pingdom response.
+// Windows are zero until a response carrying the rate limit headers has
+// been received.
+func (pc *Client) RateLimit() RateLimit {
+	return pc.limiter.get()
+}
+
 // addAuthHeaders adds the appropriate authentication headers to the request
 func (pc *Client) addAuthHeaders(req *http.Request) {
 	if pc.APITokenOnly != "" {
@@ -108,6 +142,12 @@ func (pc *Client) addAuthHeaders(req *http.Request) {
 // all caps such as GET, POST, PUT, DELETE.  The rsc param should correspond with
 // a restful resource.  Params can be passed in as a map of strings
 func (pc *Client) NewRequest(method string, rsc string, params map[string]string) (*http.Request, error) {
+	return pc.NewRequestWithContext(context.Background(), method, rsc, params)
+}
+
+// NewRequestWithContext is like NewRequest but the returned request carries
+// the given context, so it is cancelled together with ctx when passed to Do.
+func (pc *Client) NewRequestWithContext(ctx context.Context, method string, rsc string, params map[string]string) (*http.Request, error) {
 	baseURL, err := url.Parse(pc.BaseURL.String() + rsc)
 	if err != nil {
 		return nil, err
@@ -121,7 +161,7 @@ func (pc *Client) NewRequest(method string, rsc string, params map[string]string
 		baseURL.RawQuery = ps.Encode()
 	}
 
-	req, err := http.NewRequest(method, baseURL.String(), nil)
+	req, err := http.NewRequestWithContext(ctx, method, baseURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -132,7 +172,15 @@ func (pc *Client) NewRequest(method string, rsc string, params map[string]string
 	return req, nil
 }
 
+// NewRequestMultiParamValue makes a new HTTP Request like NewRequest, but
+// allows a query parameter to be repeated with several values.
 func (pc *Client) NewRequestMultiParamValue(method string, rsc string, params map[string][]string) (*http.Request, error) {
+	return pc.NewRequestMultiParamValueWithContext(context.Background(), method, rsc, params)
+}
+
+// NewRequestMultiParamValueWithContext is like NewRequestMultiParamValue but
+// the returned request carries the given context.
+func (pc *Client) NewRequestMultiParamValueWithContext(ctx context.Context, method string, rsc string, params map[string][]string) (*http.Request, error) {
 	baseURL, err := url.Parse(pc.BaseURL.String() + rsc)
 	if err != nil {
 		return nil, err
@@ -148,7 +196,7 @@ func (pc *Client) NewRequestMultiParamValue(method string, rsc string, params ma
 		baseURL.RawQuery = ps.Encode()
 	}
 
-	req, err := http.NewRequest(method, baseURL.String(), nil)
+	req, err := http.NewRequestWithContext(ctx, method, baseURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -163,6 +211,12 @@ func (pc *Client) NewRequestMultiParamValue(method string, rsc string, params ma
 // all caps such as GET, POST, PUT, DELETE.  The rsc param should correspond with
 // a restful resource.  Params should be a json formatted string.
 func (pc *Client) NewJSONRequest(method string, rsc string, params string) (*http.Request, error) {
+	return pc.NewJSONRequestWithContext(context.Background(), method, rsc, params)
+}
+
+// NewJSONRequestWithContext is like NewJSONRequest but the returned request
+// carries the given context.
+func (pc *Client) NewJSONRequestWithContext(ctx context.Context, method string, rsc string, params string) (*http.Request, error) {
 	baseURL, err := url.Parse(pc.BaseURL.String() + rsc)
 	if err != nil {
 		return nil, err
@@ -170,7 +224,7 @@ func (pc *Client) NewJSONRequest(method string, rsc string, params string) (*htt
 
 	reqBody := strings.NewReader(params)
 
-	req, err := http.NewRequest(method, baseURL.String(), reqBody)
+	req, err := http.NewRequestWithContext(ctx, method, baseURL.String(), reqBody)
 	if err != nil {
 		return nil, err
 	}
@@ -184,9 +238,11 @@ func (pc *Client) NewJSONRequest(method string, rsc string, params string) (*htt
 
 // Do makes an HTTP request and will unmarshal the JSON response in to the
 // passed in interface.  If the HTTP response is outside of the 2xx range the
-// response will be returned along with the error.
+// response will be returned along with the error.  The request is bound to
+// req.Context(), so cancelling that context aborts the call, including any
+// wait for a retry or for the rate limit to reset.
 func (pc *Client) Do(req *http.Request, v interface{}) (*http.Response, error) {
-	resp, err := pc.client.Do(req)
+	resp, err := pc.send(req)
 	if err != nil {
 		return nil, err
 	}
@@ -200,6 +256,35 @@ func (pc *Client) Do(req *http.Request, v interface{}) (*http.Response, error) {
 	return resp, err
 }
 
+// send performs the request, throttling it according to the rate limit and
+// retrying it according to the retry policy of the client.
+func (pc *Client) send(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	for attempt := 1; ; attempt++ {
+		if err := pc.limiter.wait(ctx); err != nil {
+			return nil, err
+		}
+
+		resp, err := pc.client.Do(req)
+		if err == nil {
+			pc.limiter.update(resp.Header, time.Now())
+		}
+
+		if !pc.retryPolicy.shouldRetry(req, resp, err, attempt) {
+			return resp, err
+		}
+
+		delay := pc.retryPolicy.backoff(attempt, resp)
+		discardBody(resp)
+		if err := rewindBody(req); err != nil {
+			return nil, err
+		}
+		if err := sleepContext(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
 func decodeResponse(r *http.Response, v interface{}) error {
 	if v == nil {
 		return fmt.Errorf("nil interface provided to decodeResponse")
@@ -213,19 +298,33 @@ func decodeResponse(r *http.Response, v interface{}) error {
 
 // Takes an HTTP response and determines whether it was successful.
 // Returns nil if the HTTP status code is within the 2xx range.  Returns
-// an error otherwise.
+// a *PingdomError otherwise, even when the body is not the JSON error
+// document Pingdom usually sends.
 func validateResponse(r *http.Response) error {
 	if c := r.StatusCode; 200 <= c && c <= 299 {
 		return nil
 	}
 
 	bodyBytes, _ := ioutil.ReadAll(r.Body)
-	bodyString := string(bodyBytes)
 	m := &errorJSONResponse{}
-	err := json.Unmarshal([]byte(bodyString), &m)
-	if err != nil {
-		return err
+	if err := json.Unmarshal(bodyBytes, &m); err != nil || m.Error == nil {
+		m.Error = &PingdomError{Message: truncateErrorBody(bodyBytes)}
+	}
+
+	pe := m.Error
+	if pe.StatusCode == 0 {
+		pe.StatusCode = r.StatusCode
+	}
+	if pe.StatusDesc == "" {
+		pe.StatusDesc = http.StatusText(r.StatusCode)
+	}
+	pe.Body = truncateErrorBody(bodyBytes)
+	if r.Request != nil {
+		pe.Method = r.Request.Method
+		if r.Request.URL != nil {
+			pe.Path = r.Request.URL.Path
+		}
 	}
 
-	return m.Error
+	return pe
 }
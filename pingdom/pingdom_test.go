@@ -1,6 +1,8 @@
 package pingdom
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -210,6 +212,42 @@ func TestNewRequest(t *testing.T) {
 	assert.Equal(t, client.BaseURL.String()+"/checks", req.URL.String())
 }
 
+func TestNewRequestWithContext(t *testing.T) {
+	setup()
+	defer teardown()
+
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "value")
+
+	req, err := client.NewRequestWithContext(ctx, "GET", "/checks", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "value", req.Context().Value(ctxKey{}))
+
+	req, err = client.NewJSONRequestWithContext(ctx, "POST", "/checks", "{}")
+	assert.NoError(t, err)
+	assert.Equal(t, "value", req.Context().Value(ctxKey{}))
+
+	req, err = client.NewRequestMultiParamValueWithContext(ctx, "DELETE", "/checks", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "value", req.Context().Value(ctxKey{}))
+}
+
+func TestDoCancelledContext(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	req, _ := client.NewRequestWithContext(ctx, "GET", "/", nil)
+	_, err := client.Do(req, &PingdomResponse{})
+	assert.True(t, errors.Is(err, context.Canceled))
+}
+
 func TestNewRequestWithAPITokenOnly(t *testing.T) {
 	setupWithAPITokenOnly()
 	defer teardown()
@@ -252,17 +290,68 @@ func TestValidateResponse(t *testing.T) {
 	}
 	assert.NoError(t, validateResponse(valid))
 
-	invalid := &http.Response{
-		Request:    &http.Request{},
-		StatusCode: http.StatusBadRequest,
-		Body: ioutil.NopCloser(strings.NewReader(`{
+	body := `{
 		"error" : {
 			"statuscode": 400,
 			"statusdesc": "Bad Request",
 			"errormessage": "This is an error"
 		}
-		}`)),
+		}`
+	invalid := &http.Response{
+		Request:    &http.Request{Method: "GET", URL: &url.URL{Path: "/api/3.1/checks"}},
+		StatusCode: http.StatusBadRequest,
+		Body:       ioutil.NopCloser(strings.NewReader(body)),
+	}
+	want := &PingdomError{
+		StatusCode: 400,
+		StatusDesc: "Bad Request",
+		Message:    "This is an error",
+		Method:     "GET",
+		Path:       "/api/3.1/checks",
+		Body:       body,
 	}
-	want := &PingdomError{400, "Bad Request", "This is an error"}
 	assert.Equal(t, want, validateResponse(invalid))
+	assert.True(t, errors.Is(validateResponse(invalid), ErrValidation))
+}
+
+func TestValidateResponseNonJSON(t *testing.T) {
+	resp := &http.Response{
+		Request:    &http.Request{Method: "DELETE", URL: &url.URL{Path: "/checks/1"}},
+		StatusCode: http.StatusBadGateway,
+		Body:       ioutil.NopCloser(strings.NewReader("<html>" + strings.Repeat("x", 2000) + "</html>")),
+	}
+
+	err := validateResponse(resp)
+	var pe *PingdomError
+	assert.True(t, errors.As(err, &pe))
+	assert.Equal(t, http.StatusBadGateway, pe.StatusCode)
+	assert.Equal(t, "Bad Gateway", pe.StatusDesc)
+	assert.Equal(t, "DELETE", pe.Method)
+	assert.Equal(t, "/checks/1", pe.Path)
+	assert.Equal(t, maxErrorBodyLength+len("..."), len(pe.Body))
+	assert.True(t, errors.Is(err, ErrServer))
+}
+
+func TestPingdomErrorIs(t *testing.T) {
+	tests := []struct {
+		statusCode int
+		target     error
+	}{
+		{http.StatusNotFound, ErrNotFound},
+		{http.StatusUnauthorized, ErrUnauthorized},
+		{http.StatusForbidden, ErrUnauthorized},
+		{http.StatusTooManyRequests, ErrRateLimited},
+		{http.StatusBadRequest, ErrValidation},
+		{http.StatusUnprocessableEntity, ErrValidation},
+		{http.StatusInternalServerError, ErrServer},
+		{http.StatusServiceUnavailable, ErrServer},
+	}
+	sentinels := []error{ErrNotFound, ErrUnauthorized, ErrRateLimited, ErrValidation, ErrServer}
+
+	for _, tt := range tests {
+		var err error = &PingdomError{StatusCode: tt.statusCode}
+		for _, sentinel := range sentinels {
+			assert.Equal(t, sentinel == tt.target, errors.Is(err, sentinel), "status %d, sentinel %v", tt.statusCode, sentinel)
+		}
+	}
 }
@@ -1,8 +1,7 @@
 package pingdom
 
 import (
-	"encoding/json"
-	"io/ioutil"
+	"context"
 )
 
 // ProbeService provides an interface to Pingdom probes.
@@ -12,30 +11,34 @@ type ProbeService struct {
 
 // List return a list of probes from Pingdom.
 func (cs *ProbeService) List(params ...map[string]string) ([]ProbeResponse, error) {
-	param := map[string]string{}
-	if len(params) == 1 {
-		param = params[0]
-	}
-	req, err := cs.client.NewRequest("GET", "/probes", param)
-	if err != nil {
-		return nil, err
-	}
+	return cs.ListContext(context.Background(), params...)
+}
 
-	resp, err := cs.client.client.Do(req)
+// ListContext is like List but uses the given context for the request.
+func (cs *ProbeService) ListContext(ctx context.Context, params ...map[string]string) ([]ProbeResponse, error) {
+	param := mergeParams(params)
+	req, err := cs.client.NewRequestWithContext(ctx, "GET", "/probes", param)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
 
-	if err := validateResponse(resp); err != nil {
+	p := &listProbesJSONResponse{}
+	_, err = cs.client.Do(req, p)
+	if err != nil {
 		return nil, err
 	}
 
-	bodyBytes, _ := ioutil.ReadAll(resp.Body)
-	bodyString := string(bodyBytes)
+	return p.Probes, err
+}
 
-	p := &listProbesJSONResponse{}
-	err = json.Unmarshal([]byte(bodyString), &p)
+// ListWithOptions returns a list of probes from Pingdom filtered according
+// to the given options.
+func (cs *ProbeService) ListWithOptions(opts ListProbesOptions) ([]ProbeResponse, error) {
+	return cs.ListWithOptionsContext(context.Background(), opts)
+}
 
-	return p.Probes, err
+// ListWithOptionsContext is like ListWithOptions but uses the given context
+// for the request.
+func (cs *ProbeService) ListWithOptionsContext(ctx context.Context, opts ListProbesOptions) ([]ProbeResponse, error) {
+	return cs.ListContext(ctx, opts.Params())
 }
@@ -0,0 +1,194 @@
+package pingdom
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"sort"
+	"strings"
+)
+
+// AllowlistFormat is an output format of an Allowlist.
+type AllowlistFormat int
+
+// Formats supported by Allowlist.Render.
+const (
+	// AllowlistCIDR renders one CIDR per line.
+	AllowlistCIDR AllowlistFormat = iota
+	// AllowlistNginx renders nginx `allow` directives.
+	AllowlistNginx
+	// AllowlistIPTables renders iptables and ip6tables commands accepting
+	// the probes on the INPUT chain.
+	AllowlistIPTables
+	// AllowlistNFTables renders nftables rules accepting the probes.
+	AllowlistNFTables
+	// AllowlistJSON renders a JSON document with the IPv4 and IPv6
+	// addresses separated.
+	AllowlistJSON
+)
+
+// AllowlistOptions select the probes of an Allowlist.
+type AllowlistOptions struct {
+	// OnlyActive leaves out the probes that are not active.
+	OnlyActive bool
+	// Filters keeps the probes matching any of the filters.  All probes
+	// are kept when it is empty.
+	Filters []ProbeFilter
+}
+
+// Allowlist is the set of IP addresses of Pingdom probes, sorted and
+// without duplicates, to be allowed through firewalls.
+type Allowlist struct {
+	IPv4 []string `json:"ipv4"`
+	IPv6 []string `json:"ipv6"`
+}
+
+// AllowlistDiff lists the addresses added and removed between two
+// allowlists.
+type AllowlistDiff struct {
+	Added   []string
+	Removed []string
+}
+
+// NewAllowlist returns the allowlist of the probes selected by opts.
+// Missing or malformed addresses are skipped.
+func NewAllowlist(probes []ProbeResponse, opts AllowlistOptions) Allowlist {
+	seen := map[netip.Addr]bool{}
+	var addrs []netip.Addr
+
+	for _, probe := range probes {
+		if !opts.selects(probe) {
+			continue
+		}
+		for _, ip := range []string{probe.IP, probe.IPv6} {
+			addr, err := netip.ParseAddr(ip)
+			if err != nil || seen[addr] {
+				continue
+			}
+			seen[addr] = true
+			addrs = append(addrs, addr)
+		}
+	}
+
+	sort.Slice(addrs, func(i, j int) bool { return addrs[i].Less(addrs[j]) })
+
+	a := Allowlist{IPv4: []string{}, IPv6: []string{}}
+	for _, addr := range addrs {
+		if addr.Is4() {
+			a.IPv4 = append(a.IPv4, addr.String())
+		} else {
+			a.IPv6 = append(a.IPv6, addr.String())
+		}
+	}
+	return a
+}
+
+// DiffProbes returns the addresses added and removed between two snapshots
+// of the probes selected by opts.
+func DiffProbes(old, new []ProbeResponse, opts AllowlistOptions) AllowlistDiff {
+	return NewAllowlist(old, opts).Diff(NewAllowlist(new, opts))
+}
+
+func (o AllowlistOptions) selects(probe ProbeResponse) bool {
+	if o.OnlyActive && !probe.Active {
+		return false
+	}
+	if len(o.Filters) == 0 {
+		return true
+	}
+	for _, f := range o.Filters {
+		if f.Matches(probe) {
+			return true
+		}
+	}
+	return false
+}
+
+// Addresses returns all the addresses of the allowlist, IPv4 first.
+func (a Allowlist) Addresses() []string {
+	return append(append([]string{}, a.IPv4...), a.IPv6...)
+}
+
+// CIDRs returns all the addresses of the allowlist as single host CIDRs,
+// IPv4 first.
+func (a Allowlist) CIDRs() []string {
+	cidrs := make([]string, 0, len(a.IPv4)+len(a.IPv6))
+	for _, ip := range a.IPv4 {
+		cidrs = append(cidrs, ip+"/32")
+	}
+	for _, ip := range a.IPv6 {
+		cidrs = append(cidrs, ip+"/128")
+	}
+	return cidrs
+}
+
+// Render returns the allowlist in the given format.
+func (a Allowlist) Render(format AllowlistFormat) (string, error) {
+	var b strings.Builder
+
+	switch format {
+	case AllowlistCIDR:
+		for _, cidr := range a.CIDRs() {
+			b.WriteString(cidr + "\n")
+		}
+	case AllowlistNginx:
+		for _, cidr := range a.CIDRs() {
+			fmt.Fprintf(&b, "allow %s;\n", cidr)
+		}
+	case AllowlistIPTables:
+		for _, ip := range a.IPv4 {
+			fmt.Fprintf(&b, "iptables -A INPUT -s %s/32 -j ACCEPT\n", ip)
+		}
+		for _, ip := range a.IPv6 {
+			fmt.Fprintf(&b, "ip6tables -A INPUT -s %s/128 -j ACCEPT\n", ip)
+		}
+	case AllowlistNFTables:
+		if len(a.IPv4) != 0 {
+			fmt.Fprintf(&b, "ip saddr { %s } accept\n", strings.Join(a.IPv4, ", "))
+		}
+		if len(a.IPv6) != 0 {
+			fmt.Fprintf(&b, "ip6 saddr { %s } accept\n", strings.Join(a.IPv6, ", "))
+		}
+	case AllowlistJSON:
+		j, err := json.MarshalIndent(a, "", "  ")
+		if err != nil {
+			return "", err
+		}
+		b.Write(j)
+		b.WriteString("\n")
+	default:
+		return "", fmt.Errorf("unknown allowlist format %d", format)
+	}
+
+	return b.String(), nil
+}
+
+// Diff returns the addresses added in newer and removed from a.
+func (a Allowlist) Diff(newer Allowlist) AllowlistDiff {
+	old := map[string]bool{}
+	for _, ip := range a.Addresses() {
+		old[ip] = true
+	}
+	cur := map[string]bool{}
+	for _, ip := range newer.Addresses() {
+		cur[ip] = true
+	}
+
+	var d AllowlistDiff
+	for _, ip := range newer.Addresses() {
+		if !old[ip] {
+			d.Added = append(d.Added, ip)
+		}
+	}
+	for _, ip := range a.Addresses() {
+		if !cur[ip] {
+			d.Removed = append(d.Removed, ip)
+		}
+	}
+	return d
+}
+
+// Empty reports whether no address was added or removed.
+func (d AllowlistDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0
+}
@@ -0,0 +1,88 @@
+package pingdom
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var allowlistProbes = []ProbeResponse{
+	{ID: 32, Active: true, IP: "204.152.200.42", IPv6: "2607:fcd0:100:8d00::410", CountryISO: "US", Region: "NA"},
+	{ID: 184, Active: true, IP: "52.67.148.55", IPv6: "2600:1f1e:d7c:fd05::4028", CountryISO: "BR", Region: "LATAM"},
+	{ID: 33, Active: true, IP: "5.172.196.188", CountryISO: "NL", Region: "EU"},
+	{ID: 34, Active: false, IP: "5.172.196.189", CountryISO: "NL", Region: "EU"},
+	{ID: 35, Active: true, IP: "5.172.196.188", IPv6: "not an ip", CountryISO: "NL", Region: "EU"},
+}
+
+func TestNewAllowlist(t *testing.T) {
+	assert.Equal(t, Allowlist{
+		IPv4: []string{"5.172.196.188", "5.172.196.189", "52.67.148.55", "204.152.200.42"},
+		IPv6: []string{"2600:1f1e:d7c:fd05::4028", "2607:fcd0:100:8d00::410"},
+	}, NewAllowlist(allowlistProbes, AllowlistOptions{}))
+
+	assert.Equal(t, Allowlist{
+		IPv4: []string{"5.172.196.188", "204.152.200.42"},
+		IPv6: []string{"2607:fcd0:100:8d00::410"},
+	}, NewAllowlist(allowlistProbes, AllowlistOptions{
+		OnlyActive: true,
+		Filters:    []ProbeFilter{RegionFilter(ProbeRegionEU), CountryFilter("US")},
+	}))
+
+	assert.Equal(t, Allowlist{IPv4: []string{}, IPv6: []string{}}, NewAllowlist(nil, AllowlistOptions{}))
+}
+
+func TestAllowlistRender(t *testing.T) {
+	a := Allowlist{IPv4: []string{"5.172.196.188", "52.67.148.55"}, IPv6: []string{"2600:1f1e:d7c:fd05::4028"}}
+
+	tests := []struct {
+		name   string
+		format AllowlistFormat
+		want   string
+	}{
+		{"cidr", AllowlistCIDR, "5.172.196.188/32\n52.67.148.55/32\n2600:1f1e:d7c:fd05::4028/128\n"},
+		{"nginx", AllowlistNginx, "allow 5.172.196.188/32;\nallow 52.67.148.55/32;\nallow 2600:1f1e:d7c:fd05::4028/128;\n"},
+		{"iptables", AllowlistIPTables, "iptables -A INPUT -s 5.172.196.188/32 -j ACCEPT\n" +
+			"iptables -A INPUT -s 52.67.148.55/32 -j ACCEPT\n" +
+			"ip6tables -A INPUT -s 2600:1f1e:d7c:fd05::4028/128 -j ACCEPT\n"},
+		{"nftables", AllowlistNFTables, "ip saddr { 5.172.196.188, 52.67.148.55 } accept\n" +
+			"ip6 saddr { 2600:1f1e:d7c:fd05::4028 } accept\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := a.Render(tt.format)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("json", func(t *testing.T) {
+		got, err := a.Render(AllowlistJSON)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"ipv4":["5.172.196.188","52.67.148.55"],"ipv6":["2600:1f1e:d7c:fd05::4028"]}`, got)
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		_, err := a.Render(AllowlistFormat(42))
+		assert.Error(t, err)
+	})
+}
+
+func TestDiffProbes(t *testing.T) {
+	old := []ProbeResponse{
+		{ID: 1, Active: true, IP: "1.1.1.1", IPv6: "2001:db8::1"},
+		{ID: 2, Active: true, IP: "2.2.2.2"},
+	}
+	new := []ProbeResponse{
+		{ID: 1, Active: true, IP: "1.1.1.1"},
+		{ID: 3, Active: true, IP: "3.3.3.3", IPv6: "2001:db8::3"},
+	}
+
+	d := DiffProbes(old, new, AllowlistOptions{})
+	assert.Equal(t, AllowlistDiff{
+		Added:   []string{"3.3.3.3", "2001:db8::3"},
+		Removed: []string{"2.2.2.2", "2001:db8::1"},
+	}, d)
+	assert.False(t, d.Empty())
+	assert.True(t, DiffProbes(old, old, AllowlistOptions{}).Empty())
+}
@@ -0,0 +1,150 @@
+package pingdom
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ProbeRegion is a region Pingdom probes can be filtered on.
+type ProbeRegion string
+
+// Probe regions supported by Pingdom.
+const (
+	ProbeRegionNA    ProbeRegion = "NA"
+	ProbeRegionEU    ProbeRegion = "EU"
+	ProbeRegionAPAC  ProbeRegion = "APAC"
+	ProbeRegionLATAM ProbeRegion = "LATAM"
+)
+
+var probeRegions = []ProbeRegion{ProbeRegionNA, ProbeRegionEU, ProbeRegionAPAC, ProbeRegionLATAM}
+
+// ProbeFilter restricts the probes a check runs from, either to a region or
+// to a country.  Exactly one of Region and Country is set.
+//
+// Checks take their probe filters as a string, which is built from filters
+// with EncodeProbeFilters:
+//
+//	check.ProbeFilters = pingdom.EncodeProbeFilters(pingdom.RegionFilter(pingdom.ProbeRegionEU))
+type ProbeFilter struct {
+	Region ProbeRegion
+	// Country is the ISO 3166-1 alpha-2 code of a country, as found in
+	// ProbeResponse.CountryISO.
+	Country string
+}
+
+// RegionFilter returns a ProbeFilter on the given region.
+func RegionFilter(region ProbeRegion) ProbeFilter {
+	return ProbeFilter{Region: region}
+}
+
+// CountryFilter returns a ProbeFilter on the country with the given ISO code.
+func CountryFilter(iso string) ProbeFilter {
+	return ProbeFilter{Country: strings.ToUpper(iso)}
+}
+
+// ParseProbeFilter parses a filter of the form `region: NA` or
+// `country: US`, as returned in CheckResponse.ProbeFilters.
+func ParseProbeFilter(s string) (ProbeFilter, error) {
+	key, value, ok := strings.Cut(s, ":")
+	key = strings.TrimSpace(key)
+	value = strings.TrimSpace(value)
+	if !ok || value == "" {
+		return ProbeFilter{}, fmt.Errorf("invalid probe filter %q, must be of the form `key: value`", strings.TrimSpace(s))
+	}
+
+	var f ProbeFilter
+	switch key {
+	case "region":
+		f = RegionFilter(ProbeRegion(value))
+	case "country":
+		f = CountryFilter(value)
+	default:
+		return ProbeFilter{}, fmt.Errorf("invalid probe filter key %q, allowed keys are region, country", key)
+	}
+
+	return f, f.Valid()
+}
+
+// ParseProbeFilters parses the probe filters of a CheckResponse.  Each
+// element may itself hold several comma separated filters.
+func ParseProbeFilters(filters []string) ([]ProbeFilter, error) {
+	var parsed []ProbeFilter
+	for _, s := range filters {
+		for _, part := range strings.Split(s, ",") {
+			if strings.TrimSpace(part) == "" {
+				continue
+			}
+			f, err := ParseProbeFilter(part)
+			if err != nil {
+				return nil, err
+			}
+			parsed = append(parsed, f)
+		}
+	}
+	return parsed, nil
+}
+
+// EncodeProbeFilters returns the probe filters in the form expected by the
+// ProbeFilters field of checks.
+func EncodeProbeFilters(filters ...ProbeFilter) string {
+	parts := make([]string, len(filters))
+	for i, f := range filters {
+		parts[i] = f.String()
+	}
+	return strings.Join(parts, ",")
+}
+
+// String returns the filter in the form used by the Pingdom API.
+func (f ProbeFilter) String() string {
+	if f.Country != "" {
+		return "country: " + f.Country
+	}
+	return "region: " + string(f.Region)
+}
+
+// Valid determines whether the ProbeFilter contains valid fields.
+func (f ProbeFilter) Valid() error {
+	if (f.Region == "") == (f.Country == "") {
+		return fmt.Errorf("invalid probe filter, exactly one of `Region` and `Country` must be set")
+	}
+
+	if f.Country != "" {
+		if !validCountryISO(f.Country) {
+			return fmt.Errorf("invalid country %q in probe filter, must be an ISO 3166-1 alpha-2 code", f.Country)
+		}
+		return nil
+	}
+
+	for _, r := range probeRegions {
+		if f.Region == r {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid region %q in probe filter, allowed values are NA, EU, APAC, LATAM", f.Region)
+}
+
+// Matches reports whether the probe is selected by the filter.
+func (f ProbeFilter) Matches(probe ProbeResponse) bool {
+	if f.Country != "" {
+		return strings.EqualFold(probe.CountryISO, f.Country)
+	}
+	return probe.Region == string(f.Region)
+}
+
+// validProbeFilters checks the ProbeFilters field of a check.
+func validProbeFilters(filters string) error {
+	_, err := ParseProbeFilters([]string{filters})
+	return err
+}
+
+func validCountryISO(iso string) bool {
+	if len(iso) != 2 {
+		return false
+	}
+	for _, c := range iso {
+		if c < 'A' || c > 'Z' {
+			return false
+		}
+	}
+	return true
+}
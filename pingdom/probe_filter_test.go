@@ -0,0 +1,76 @@
+package pingdom
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseProbeFilter(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    ProbeFilter
+		wantErr bool
+	}{
+		{in: "region: NA", want: RegionFilter(ProbeRegionNA)},
+		{in: "region:LATAM", want: RegionFilter(ProbeRegionLATAM)},
+		{in: " country: se ", want: CountryFilter("SE")},
+		{in: "region: Europe", wantErr: true},
+		{in: "country: SWE", wantErr: true},
+		{in: "continent: EU", wantErr: true},
+		{in: "region", wantErr: true},
+		{in: "region: ", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseProbeFilter(tt.in)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseProbeFilters(t *testing.T) {
+	filters, err := ParseProbeFilters([]string{"region: EU", "region: NA,country: US", ""})
+	assert.NoError(t, err)
+	assert.Equal(t, []ProbeFilter{
+		RegionFilter(ProbeRegionEU),
+		RegionFilter(ProbeRegionNA),
+		CountryFilter("US"),
+	}, filters)
+
+	_, err = ParseProbeFilters([]string{"region: EU", "region: Mars"})
+	assert.Error(t, err)
+}
+
+func TestEncodeProbeFilters(t *testing.T) {
+	assert.Equal(t, "", EncodeProbeFilters())
+	assert.Equal(t, "region: APAC,country: JP", EncodeProbeFilters(RegionFilter(ProbeRegionAPAC), CountryFilter("jp")))
+
+	check := HttpCheck{Name: "fake check", Hostname: "example.com", ProbeFilters: EncodeProbeFilters(RegionFilter(ProbeRegionEU))}
+	assert.Equal(t, "region: EU", check.PutParams()["probe_filters"])
+	assert.NoError(t, check.Valid())
+}
+
+func TestProbeFilterValid(t *testing.T) {
+	assert.NoError(t, RegionFilter(ProbeRegionNA).Valid())
+	assert.NoError(t, CountryFilter("us").Valid())
+	assert.Error(t, ProbeFilter{}.Valid())
+	assert.Error(t, ProbeFilter{Region: ProbeRegionNA, Country: "US"}.Valid())
+	assert.Error(t, RegionFilter("na").Valid())
+	assert.Error(t, CountryFilter("U1").Valid())
+}
+
+func TestProbeFilterMatches(t *testing.T) {
+	probe := ProbeResponse{ID: 32, CountryISO: "US", Region: "NA"}
+
+	assert.True(t, RegionFilter(ProbeRegionNA).Matches(probe))
+	assert.False(t, RegionFilter(ProbeRegionEU).Matches(probe))
+	assert.True(t, CountryFilter("us").Matches(probe))
+	assert.False(t, CountryFilter("CA").Matches(probe))
+}
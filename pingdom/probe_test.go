@@ -76,3 +76,34 @@ func TestProbesServiceList(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, want, probes, "Probes.List() should return correct result")
 }
+
+func TestProbesServiceListWithOptions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/probes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, "true", r.URL.Query().Get("onlyactive"))
+		assert.Equal(t, "", r.URL.Query().Get("includedeleted"))
+		fmt.Fprint(w, `{"probes": [{"id": 32, "active": true}]}`)
+	})
+
+	probes, err := client.Probes.ListWithOptions(ListProbesOptions{OnlyActive: true})
+	assert.NoError(t, err)
+	assert.Equal(t, []ProbeResponse{{ID: 32, Active: true}}, probes)
+}
+
+func TestProbesServiceListMergesParams(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/probes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, "true", r.URL.Query().Get("onlyactive"))
+		assert.Equal(t, "true", r.URL.Query().Get("includedeleted"))
+		fmt.Fprint(w, `{"probes": []}`)
+	})
+
+	_, err := client.Probes.List(map[string]string{"onlyactive": "true"}, map[string]string{"includedeleted": "true"})
+	assert.NoError(t, err)
+}
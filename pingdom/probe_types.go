@@ -0,0 +1,37 @@
+package pingdom
+
+import (
+	"strconv"
+)
+
+// ListProbesOptions are the parameters of a Pingdom probe list request.
+// Zero values are not sent.
+type ListProbesOptions struct {
+	Limit          int
+	Offset         int
+	OnlyActive     bool
+	IncludeDeleted bool
+}
+
+// Params returns the query parameters of a ListProbesOptions.
+func (o ListProbesOptions) Params() map[string]string {
+	m := map[string]string{}
+
+	if o.Limit != 0 {
+		m["limit"] = strconv.Itoa(o.Limit)
+	}
+
+	if o.Offset != 0 {
+		m["offset"] = strconv.Itoa(o.Offset)
+	}
+
+	if o.OnlyActive {
+		m["onlyactive"] = "true"
+	}
+
+	if o.IncludeDeleted {
+		m["includedeleted"] = "true"
+	}
+
+	return m
+}
@@ -0,0 +1,20 @@
+package pingdom
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListProbesOptionsParams(t *testing.T) {
+	assert.Equal(t, map[string]string{}, ListProbesOptions{}.Params())
+
+	opts := ListProbesOptions{Limit: 5, Offset: 10, OnlyActive: true, IncludeDeleted: true}
+	want := map[string]string{
+		"limit":          "5",
+		"offset":         "10",
+		"onlyactive":     "true",
+		"includedeleted": "true",
+	}
+	assert.Equal(t, want, opts.Params())
+}
@@ -0,0 +1,144 @@
+package pingdom
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	headerReqLimitShort = "Req-Limit-Short"
+	headerReqLimitLong  = "Req-Limit-Long"
+)
+
+var reqLimitRegexp = regexp.MustCompile(`Remaining:\s*(\d+)\s*Time until reset:\s*(\d+)`)
+
+// RateLimit represents the API budget left, as reported by the
+// Req-Limit-Short and Req-Limit-Long headers of the last Pingdom response.
+type RateLimit struct {
+	// Short is the short-term window.
+	Short RateLimitWindow
+	// Long is the long-term window.
+	Long RateLimitWindow
+}
+
+// RateLimitWindow is the state of one of the Pingdom rate limit windows.
+type RateLimitWindow struct {
+	// Remaining is the number of requests left in the window.
+	Remaining int
+	// Reset is the time at which the window resets.  It is the zero time
+	// when Pingdom has not reported this window yet.
+	Reset time.Time
+}
+
+// Known reports whether Pingdom has reported this window.
+func (w RateLimitWindow) Known() bool {
+	return !w.Reset.IsZero()
+}
+
+// ParseRateLimit extracts the rate limit windows from the headers of a
+// Pingdom response.  Windows missing from the headers are left zero.
+func ParseRateLimit(h http.Header) RateLimit {
+	now := time.Now()
+	short, _ := parseReqLimit(h.Get(headerReqLimitShort), now)
+	long, _ := parseReqLimit(h.Get(headerReqLimitLong), now)
+	return RateLimit{Short: short, Long: long}
+}
+
+// rateLimiter keeps track of the Pingdom rate limit headers and, when
+// throttling is enabled, delays requests until the exhausted window resets.
+type rateLimiter struct {
+	mu       sync.Mutex
+	throttle bool
+	onUpdate func(RateLimit)
+	current  RateLimit
+}
+
+// update records the rate limit state from the headers of a response.
+func (rl *rateLimiter) update(h http.Header, now time.Time) {
+	if rl == nil {
+		return
+	}
+	short, okShort := parseReqLimit(h.Get(headerReqLimitShort), now)
+	long, okLong := parseReqLimit(h.Get(headerReqLimitLong), now)
+	if !okShort && !okLong {
+		return
+	}
+
+	rl.mu.Lock()
+	if okShort {
+		rl.current.Short = short
+	}
+	if okLong {
+		rl.current.Long = long
+	}
+	current := rl.current
+	rl.mu.Unlock()
+
+	if rl.onUpdate != nil {
+		rl.onUpdate(current)
+	}
+}
+
+// get returns the last recorded rate limit state.
+func (rl *rateLimiter) get() RateLimit {
+	if rl == nil {
+		return RateLimit{}
+	}
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	return rl.current
+}
+
+// delay returns how long a request must wait before being sent so that it
+// is not rejected because of an exhausted window.
+func (rl *rateLimiter) delay(now time.Time) time.Duration {
+	if rl == nil {
+		return 0
+	}
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	if !rl.throttle {
+		return 0
+	}
+
+	var d time.Duration
+	for _, w := range []RateLimitWindow{rl.current.Short, rl.current.Long} {
+		if !w.Known() || w.Remaining > 0 {
+			continue
+		}
+		if wait := w.Reset.Sub(now); wait > d {
+			d = wait
+		}
+	}
+	return d
+}
+
+// wait blocks until the request may be sent or ctx is done.
+func (rl *rateLimiter) wait(ctx context.Context) error {
+	return sleepContext(ctx, rl.delay(time.Now()))
+}
+
+// parseReqLimit parses a header of the form
+// "Remaining: 394 Time until reset: 3589".
+func parseReqLimit(v string, now time.Time) (RateLimitWindow, bool) {
+	match := reqLimitRegexp.FindStringSubmatch(v)
+	if match == nil {
+		return RateLimitWindow{}, false
+	}
+	remaining, err := strconv.Atoi(match[1])
+	if err != nil {
+		return RateLimitWindow{}, false
+	}
+	secs, err := strconv.Atoi(match[2])
+	if err != nil {
+		return RateLimitWindow{}, false
+	}
+	return RateLimitWindow{
+		Remaining: remaining,
+		Reset:     now.Add(time.Duration(secs) * time.Second),
+	}, true
+}
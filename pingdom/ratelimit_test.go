@@ -0,0 +1,85 @@
+package pingdom
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseReqLimit(t *testing.T) {
+	now := time.Unix(1600000000, 0)
+
+	w, ok := parseReqLimit("Remaining: 394 Time until reset: 3589", now)
+	assert.True(t, ok)
+	assert.Equal(t, 394, w.Remaining)
+	assert.Equal(t, now.Add(3589*time.Second), w.Reset)
+
+	_, ok = parseReqLimit("", now)
+	assert.False(t, ok)
+
+	_, ok = parseReqLimit("garbage", now)
+	assert.False(t, ok)
+}
+
+func TestRateLimiterDelay(t *testing.T) {
+	now := time.Unix(1600000000, 0)
+	h := http.Header{}
+	h.Set(headerReqLimitShort, "Remaining: 0 Time until reset: 30")
+	h.Set(headerReqLimitLong, "Remaining: 1000 Time until reset: 86400")
+
+	rl := &rateLimiter{}
+	rl.update(h, now)
+	assert.Equal(t, time.Duration(0), rl.delay(now), "throttling disabled")
+
+	rl.throttle = true
+	assert.Equal(t, 30*time.Second, rl.delay(now))
+	assert.Equal(t, time.Duration(0), rl.delay(now.Add(time.Minute)))
+
+	h.Set(headerReqLimitShort, "Remaining: 10 Time until reset: 30")
+	rl.update(h, now)
+	assert.Equal(t, time.Duration(0), rl.delay(now))
+
+	var nilLimiter *rateLimiter
+	assert.Equal(t, time.Duration(0), nilLimiter.delay(now))
+}
+
+func TestParseRateLimit(t *testing.T) {
+	h := http.Header{}
+	h.Set(headerReqLimitLong, "Remaining: 71994 Time until reset: 2591989")
+
+	rl := ParseRateLimit(h)
+	assert.False(t, rl.Short.Known())
+	assert.True(t, rl.Long.Known())
+	assert.Equal(t, 71994, rl.Long.Remaining)
+}
+
+func TestClientRateLimit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var hooked []RateLimit
+	client.limiter.onUpdate = func(rl RateLimit) {
+		hooked = append(hooked, rl)
+	}
+
+	mux.HandleFunc("/checks", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerReqLimitShort, "Remaining: 394 Time until reset: 3589")
+		w.Header().Set(headerReqLimitLong, "Remaining: 71994 Time until reset: 2591989")
+		fmt.Fprint(w, `{"checks": []}`)
+	})
+
+	assert.False(t, client.RateLimit().Short.Known())
+
+	start := time.Now()
+	_, err := client.Checks.List()
+	assert.NoError(t, err)
+
+	rl := client.RateLimit()
+	assert.Equal(t, 394, rl.Short.Remaining)
+	assert.Equal(t, 71994, rl.Long.Remaining)
+	assert.True(t, !rl.Short.Reset.Before(start.Add(3589*time.Second)))
+	assert.Equal(t, []RateLimit{rl}, hooked)
+}
@@ -0,0 +1,32 @@
+package pingdom
+
+import (
+	"context"
+)
+
+// ReferenceService provides an interface to the Pingdom reference data:
+// regions, timezones, date and number formats, countries and phone codes.
+type ReferenceService struct {
+	client *Client
+}
+
+// Get returns the Pingdom reference data.
+func (cs *ReferenceService) Get() (*ReferenceResponse, error) {
+	return cs.GetContext(context.Background())
+}
+
+// GetContext is like Get but uses the given context for the request.
+func (cs *ReferenceService) GetContext(ctx context.Context) (*ReferenceResponse, error) {
+	req, err := cs.client.NewRequestWithContext(ctx, "GET", "/reference", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ReferenceResponse{}
+	_, err = cs.client.Do(req, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return r, err
+}
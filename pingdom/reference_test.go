@@ -0,0 +1,53 @@
+package pingdom
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReferenceServiceGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/reference", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"regions": [
+				{"id": 1, "description": "United States (en-US)", "countryid": 1, "datetimeformatid": 1, "numberformatid": 1, "timezoneid": "America/New_York"}
+			],
+			"timezones": [
+				{"id": "America/New_York", "description": "(GMT -5:00) Eastern Time (US & Canada)"}
+			],
+			"datetimeformats": [
+				{"id": 1, "description": "MM/DD/YYYY hh:mm:ss"}
+			],
+			"numberformats": [
+				{"id": 1, "description": "123,456,789.00"}
+			],
+			"countries": [
+				{"id": 1, "iso": "US"}
+			],
+			"phonecodes": [
+				{"countryid": 1, "name": "United States", "phonecode": "1"}
+			]
+		}`)
+	})
+
+	reference, err := client.Reference.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, &ReferenceResponse{
+		Regions: []ReferenceRegion{
+			{ID: 1, Description: "United States (en-US)", CountryID: 1, DatetimeFormatID: 1, NumberFormatID: 1, TimezoneID: "America/New_York"},
+		},
+		Timezones: []ReferenceTimezone{
+			{ID: "America/New_York", Description: "(GMT -5:00) Eastern Time (US & Canada)"},
+		},
+		DatetimeFormats: []ReferenceFormat{{ID: 1, Description: "MM/DD/YYYY hh:mm:ss"}},
+		NumberFormats:   []ReferenceFormat{{ID: 1, Description: "123,456,789.00"}},
+		Countries:       []ReferenceCountry{{ID: 1, ISO: "US"}},
+		PhoneCodes:      []ReferencePhoneCode{{CountryID: 1, Name: "United States", PhoneCode: "1"}},
+	}, reference)
+}
@@ -0,0 +1,45 @@
+package pingdom
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ValidProbeFilters checks the probe filters of a check, as set in
+// HttpCheck.ProbeFilters, against the reference data.  On top of the checks
+// of ParseProbeFilters, countries must be known to the reference data.
+func (r *ReferenceResponse) ValidProbeFilters(filters string) error {
+	parsed, err := ParseProbeFilters([]string{filters})
+	if err != nil {
+		return err
+	}
+
+	for _, f := range parsed {
+		if f.Country != "" && !r.validCountry(f.Country) {
+			return fmt.Errorf("invalid country %q in probe filters, not found in the reference data", f.Country)
+		}
+	}
+
+	return nil
+}
+
+// ValidTimezone checks a timezone identifier, such as `Europe/Stockholm`,
+// against the reference data.
+func (r *ReferenceResponse) ValidTimezone(timezone string) error {
+	for _, tz := range r.Timezones {
+		if tz.ID == timezone {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("invalid timezone %q, not found in the reference data", timezone)
+}
+
+func (r *ReferenceResponse) validCountry(iso string) bool {
+	for _, c := range r.Countries {
+		if strings.EqualFold(c.ISO, iso) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,42 @@
+package pingdom
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReferenceResponseValidProbeFilters(t *testing.T) {
+	reference := &ReferenceResponse{Countries: []ReferenceCountry{{ID: 1, ISO: "US"}, {ID: 2, ISO: "SE"}}}
+
+	tests := []struct {
+		filters string
+		valid   bool
+	}{
+		{"", true},
+		{"region: NA", true},
+		{"region:EU,country: se", true},
+		{"region: Europe", false},
+		{"country: XX", false},
+		{"continent: EU", false},
+		{"region", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filters, func(t *testing.T) {
+			err := reference.ValidProbeFilters(tt.filters)
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestReferenceResponseValidTimezone(t *testing.T) {
+	reference := &ReferenceResponse{Timezones: []ReferenceTimezone{{ID: "Europe/Stockholm"}}}
+
+	assert.NoError(t, reference.ValidTimezone("Europe/Stockholm"))
+	assert.Error(t, reference.ValidTimezone("Europe/Atlantis"))
+}
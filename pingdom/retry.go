@@ -0,0 +1,169 @@
+package pingdom
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultRetryMaxAttempts = 3
+	defaultRetryMinBackoff  = 1 * time.Second
+	defaultRetryMaxBackoff  = 30 * time.Second
+)
+
+// RetryPolicy configures how Client.Do retries requests that failed with a
+// network error, a 429 Too Many Requests or a 5xx response.
+//
+// Zero values for MaxAttempts, MinBackoff and MaxBackoff are replaced with
+// defaults of 3 attempts, 1 second and 30 seconds respectively.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	MaxAttempts int
+	// MinBackoff is the base delay before the first retry. It doubles on
+	// every subsequent retry.
+	MinBackoff time.Duration
+	// MaxBackoff caps the computed exponential delay.
+	MaxBackoff time.Duration
+	// RetryNonIdempotent allows retrying POST and PATCH requests, which may
+	// create duplicate resources if the first attempt reached Pingdom.
+	RetryNonIdempotent bool
+}
+
+func (rp *RetryPolicy) maxAttempts() int {
+	if rp == nil {
+		return 1
+	}
+	if rp.MaxAttempts == 0 {
+		return defaultRetryMaxAttempts
+	}
+	return rp.MaxAttempts
+}
+
+// shouldRetry determines whether the outcome of the given attempt warrants
+// another one.
+func (rp *RetryPolicy) shouldRetry(req *http.Request, resp *http.Response, err error, attempt int) bool {
+	if attempt >= rp.maxAttempts() {
+		return false
+	}
+	if req.Context().Err() != nil {
+		return false
+	}
+	if !rp.RetryNonIdempotent && !isIdempotent(req.Method) {
+		return false
+	}
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		// The body cannot be replayed.
+		return false
+	}
+	if err != nil {
+		return true
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// backoff returns how long to wait before the next attempt.  A Retry-After
+// header on the response takes precedence over the exponential delay.
+func (rp *RetryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if d, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return d
+		}
+	}
+
+	minBackoff := rp.MinBackoff
+	if minBackoff == 0 {
+		minBackoff = defaultRetryMinBackoff
+	}
+	maxBackoff := rp.MaxBackoff
+	if maxBackoff == 0 {
+		maxBackoff = defaultRetryMaxBackoff
+	}
+
+	d := minBackoff << uint(attempt-1)
+	if d > maxBackoff || d <= 0 {
+		d = maxBackoff
+	}
+
+	// Equal jitter: keep half of the delay and randomise the other half.
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case "GET", "HEAD", "OPTIONS", "PUT", "DELETE":
+		return true
+	}
+	return false
+}
+
+// parseRetryAfter understands both forms of the Retry-After header: a
+// number of seconds or an HTTP date.
+func parseRetryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+	return 0, false
+}
+
+// rewindBody resets the request body so that the request can be sent again.
+func rewindBody(req *http.Request) error {
+	if req.GetBody == nil {
+		return nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return err
+	}
+	req.Body = body
+	return nil
+}
+
+// discardBody drains and closes a response body so that the underlying
+// connection can be reused.
+func discardBody(resp *http.Response) {
+	if resp == nil || resp.Body == nil {
+		return
+	}
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	resp.Body.Close()
+}
+
+// sleepContext waits for d or until ctx is done, whichever comes first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,148 @@
+package pingdom
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func setupWithRetryPolicy(policy *RetryPolicy) {
+	setup()
+	client, _ = NewClientWithConfig(ClientConfig{
+		APIToken:    "my_api_token",
+		RetryPolicy: policy,
+	})
+	url, _ := url.Parse(server.URL)
+	client.BaseURL = url
+}
+
+func TestDoRetriesServerErrors(t *testing.T) {
+	setupWithRetryPolicy(&RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond})
+	defer teardown()
+
+	attempts := 0
+	mux.HandleFunc("/checks", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprint(w, `{"error":{"statuscode":503,"statusdesc":"Service Unavailable","errormessage":"try later"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"checks": []}`)
+	})
+
+	_, err := client.Checks.List()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, attempts)
+}
+
+func TestDoRetryGivesUp(t *testing.T) {
+	setupWithRetryPolicy(&RetryPolicy{MaxAttempts: 2, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond})
+	defer teardown()
+
+	attempts := 0
+	mux.HandleFunc("/checks", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusTooManyRequests)
+		fmt.Fprint(w, `{"error":{"statuscode":429,"statusdesc":"Too Many Requests","errormessage":"slow down"}}`)
+	})
+
+	_, err := client.Checks.List()
+	assert.Error(t, err)
+	assert.Equal(t, 2, attempts)
+}
+
+func TestDoDoesNotRetryNonIdempotent(t *testing.T) {
+	setupWithRetryPolicy(&RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond})
+	defer teardown()
+
+	attempts := 0
+	mux.HandleFunc("/checks", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusBadGateway)
+		fmt.Fprint(w, `{"error":{"statuscode":502,"statusdesc":"Bad Gateway","errormessage":"oops"}}`)
+	})
+
+	_, err := client.Checks.Create(&PingCheck{Name: "test", Hostname: "example.com"})
+	assert.Error(t, err)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestDoRetryReplaysJSONBody(t *testing.T) {
+	setupWithRetryPolicy(&RetryPolicy{MaxAttempts: 2, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond})
+	defer teardown()
+
+	var bodies []string
+	mux.HandleFunc("/alerting/teams/1", func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		if len(bodies) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprint(w, `{"error":{"statuscode":500,"statusdesc":"Internal Server Error","errormessage":"oops"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"team":{"id":1,"name":"team"}}`)
+	})
+
+	_, err := client.Teams.Update(1, &Team{Name: "team"})
+	assert.NoError(t, err)
+	assert.Len(t, bodies, 2)
+	assert.Equal(t, bodies[0], bodies[1])
+}
+
+func TestDoRetryStopsOnCancelledContext(t *testing.T) {
+	setupWithRetryPolicy(&RetryPolicy{MaxAttempts: 5, MinBackoff: time.Hour, MaxBackoff: time.Hour})
+	defer teardown()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	attempts := 0
+	mux.HandleFunc("/checks", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		cancel()
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, err := client.Checks.ListContext(ctx)
+	assert.Error(t, err)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	rp := &RetryPolicy{MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+
+	for attempt, max := range map[int]time.Duration{
+		1: 100 * time.Millisecond,
+		2: 200 * time.Millisecond,
+		3: 400 * time.Millisecond,
+		5: time.Second,
+		9: time.Second,
+	} {
+		d := rp.backoff(attempt, nil)
+		assert.True(t, d >= max/2 && d <= max, "attempt %d: %v not in [%v, %v]", attempt, d, max/2, max)
+	}
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"7"}}}
+	assert.Equal(t, 7*time.Second, rp.backoff(1, resp))
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	d, ok := parseRetryAfter("120")
+	assert.True(t, ok)
+	assert.Equal(t, 2*time.Minute, d)
+
+	d, ok = parseRetryAfter(time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat))
+	assert.True(t, ok)
+	assert.Equal(t, time.Duration(0), d)
+
+	_, ok = parseRetryAfter("")
+	assert.False(t, ok)
+
+	_, ok = parseRetryAfter("soon")
+	assert.False(t, ok)
+}
@@ -0,0 +1,151 @@
+package pingdom
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+)
+
+// SummaryAverageResponse represents the JSON response for a summary average from the Pingdom API.
+type SummaryAverageResponse struct {
+	Summary SummaryAverage `json:"summary"`
+}
+
+// SummaryAverage is the average response time of a check, and its uptime
+// when requested.
+type SummaryAverage struct {
+	ResponseTime SummaryAverageResponseTime `json:"responsetime"`
+	Status       *SummaryAverageStatus      `json:"status,omitempty"`
+}
+
+// SummaryAverageResponseTime is the average response time over a period.
+// Depending on the request, either AvgResponse or one of the breakdowns
+// ByProbe and ByCountry is set.
+type SummaryAverageResponseTime struct {
+	From        int64
+	To          int64
+	AvgResponse int
+	ByProbe     []SummaryAverageProbe
+	ByCountry   []SummaryAverageCountry
+}
+
+// SummaryAverageProbe is the average response time measured by one probe.
+type SummaryAverageProbe struct {
+	ProbeID     int `json:"probeid"`
+	AvgResponse int `json:"avgresponse"`
+	N           int `json:"n"`
+}
+
+// SummaryAverageCountry is the average response time measured from one country.
+type SummaryAverageCountry struct {
+	CountryISO  string `json:"countryiso"`
+	AvgResponse int    `json:"avgresponse"`
+}
+
+// SummaryAverageStatus is the time, in seconds, spent in each state.
+type SummaryAverageStatus struct {
+	TotalUp      int `json:"totalup"`
+	TotalDown    int `json:"totaldown"`
+	TotalUnknown int `json:"totalunknown"`
+}
+
+// UnmarshalJSON converts a byte array into a SummaryAverageResponseTime.
+// The `avgresponse` field is either a number or a per probe or per country
+// breakdown.
+func (r *SummaryAverageResponseTime) UnmarshalJSON(b []byte) error {
+	var raw struct {
+		From        int64           `json:"from"`
+		To          int64           `json:"to"`
+		AvgResponse json.RawMessage `json:"avgresponse"`
+	}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	r.From = raw.From
+	r.To = raw.To
+
+	avg := bytes.TrimSpace(raw.AvgResponse)
+	if len(avg) == 0 || avg[0] != '[' {
+		if len(avg) == 0 {
+			return nil
+		}
+		return json.Unmarshal(avg, &r.AvgResponse)
+	}
+
+	var entries []struct {
+		ProbeID     *int   `json:"probeid"`
+		CountryISO  string `json:"countryiso"`
+		AvgResponse int    `json:"avgresponse"`
+		N           int    `json:"n"`
+	}
+	if err := json.Unmarshal(avg, &entries); err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if e.ProbeID != nil {
+			r.ByProbe = append(r.ByProbe, SummaryAverageProbe{ProbeID: *e.ProbeID, AvgResponse: e.AvgResponse, N: e.N})
+		} else {
+			r.ByCountry = append(r.ByCountry, SummaryAverageCountry{CountryISO: e.CountryISO, AvgResponse: e.AvgResponse})
+		}
+	}
+	return nil
+}
+
+// SummaryOutageResponse represents the JSON response for a summary outage from the Pingdom API.
+type SummaryOutageResponse struct {
+	Summary SummaryOutage `json:"summary"`
+}
+
+// SummaryOutage is the list of state changes of a check over a period.
+type SummaryOutage struct {
+	States []SummaryOutageState `json:"states"`
+}
+
+// SummaryOutageState is an interval during which a check was in the same state.
+type SummaryOutageState struct {
+	Status   string `json:"status"`
+	TimeFrom int64  `json:"timefrom"`
+	TimeTo   int64  `json:"timeto"`
+}
+
+// Start returns the start time of the interval.
+func (s SummaryOutageState) Start() time.Time {
+	return time.Unix(s.TimeFrom, 0)
+}
+
+// End returns the end time of the interval.
+func (s SummaryOutageState) End() time.Time {
+	return time.Unix(s.TimeTo, 0)
+}
+
+// Duration returns the length of the interval.
+func (s SummaryOutageState) Duration() time.Duration {
+	return time.Duration(s.TimeTo-s.TimeFrom) * time.Second
+}
+
+// Outages returns the intervals during which the check was down.
+func (s SummaryOutage) Outages() []SummaryOutageState {
+	var outages []SummaryOutageState
+	for _, state := range s.States {
+		if state.Status == "down" {
+			outages = append(outages, state)
+		}
+	}
+	return outages
+}
+
+// SummaryHoursOfDayResponse represents the JSON response for a summary hours of day from the Pingdom API.
+type SummaryHoursOfDayResponse struct {
+	HoursOfDay []SummaryHourOfDay `json:"hoursofday"`
+}
+
+// SummaryHourOfDay is the average response time for one hour of the day.
+type SummaryHourOfDay struct {
+	Hour        int `json:"hour"`
+	AvgResponse int `json:"avgresponse"`
+}
+
+// SummaryProbesResponse represents the JSON response for a summary probes from the Pingdom API.
+type SummaryProbesResponse struct {
+	Probes []int `json:"probes"`
+}
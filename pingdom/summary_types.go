@@ -0,0 +1,170 @@
+package pingdom
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// SummaryAverageRequest is the API request to Pingdom for a SummaryAverage.
+type SummaryAverageRequest struct {
+	Id            int
+	From          time.Time
+	To            time.Time
+	Probes        []int
+	IncludeUptime bool
+	// ByCountry and ByProbe break the average response time down per
+	// country or per probe.  They are mutually exclusive.
+	ByCountry bool
+	ByProbe   bool
+}
+
+// SummaryOutageRequest is the API request to Pingdom for a SummaryOutage.
+type SummaryOutageRequest struct {
+	Id    int
+	From  time.Time
+	To    time.Time
+	Order string
+}
+
+// SummaryHoursOfDayRequest is the API request to Pingdom for a SummaryHoursOfDay.
+type SummaryHoursOfDayRequest struct {
+	Id           int
+	From         time.Time
+	To           time.Time
+	Probes       []int
+	UseLocalTime bool
+}
+
+// SummaryProbesRequest is the API request to Pingdom for a SummaryProbes.
+type SummaryProbesRequest struct {
+	Id   int
+	From time.Time
+	To   time.Time
+}
+
+// Valid determines whether a SummaryAverageRequest contains valid fields for the Pingdom API.
+func (r SummaryAverageRequest) Valid() error {
+	if r.Id == 0 {
+		return ErrMissingId
+	}
+
+	if r.ByCountry && r.ByProbe {
+		return fmt.Errorf("`ByCountry` and `ByProbe` must not be declared at the same time")
+	}
+
+	return validTimeRange(r.From, r.To)
+}
+
+// GetParams returns a map of params for a Pingdom SummaryAverageRequest.
+func (r SummaryAverageRequest) GetParams() map[string]string {
+	params := timeRangeParams(r.From, r.To)
+
+	if len(r.Probes) != 0 {
+		params["probes"] = intListToCDString(r.Probes)
+	}
+
+	if r.IncludeUptime {
+		params["includeuptime"] = "true"
+	}
+
+	if r.ByCountry {
+		params["bycountry"] = "true"
+	}
+
+	if r.ByProbe {
+		params["byprobe"] = "true"
+	}
+
+	return params
+}
+
+// Valid determines whether a SummaryOutageRequest contains valid fields for the Pingdom API.
+func (r SummaryOutageRequest) Valid() error {
+	if r.Id == 0 {
+		return ErrMissingId
+	}
+
+	if r.Order != "" && r.Order != "asc" && r.Order != "desc" {
+		return ErrBadOrder
+	}
+
+	return validTimeRange(r.From, r.To)
+}
+
+// GetParams returns a map of params for a Pingdom SummaryOutageRequest.
+func (r SummaryOutageRequest) GetParams() map[string]string {
+	params := timeRangeParams(r.From, r.To)
+
+	if r.Order != "" {
+		params["order"] = r.Order
+	}
+
+	return params
+}
+
+// Valid determines whether a SummaryHoursOfDayRequest contains valid fields for the Pingdom API.
+func (r SummaryHoursOfDayRequest) Valid() error {
+	if r.Id == 0 {
+		return ErrMissingId
+	}
+
+	return validTimeRange(r.From, r.To)
+}
+
+// GetParams returns a map of params for a Pingdom SummaryHoursOfDayRequest.
+func (r SummaryHoursOfDayRequest) GetParams() map[string]string {
+	params := timeRangeParams(r.From, r.To)
+
+	if len(r.Probes) != 0 {
+		params["probes"] = intListToCDString(r.Probes)
+	}
+
+	if r.UseLocalTime {
+		params["uselocaltime"] = "true"
+	}
+
+	return params
+}
+
+// Valid determines whether a SummaryProbesRequest contains valid fields for the Pingdom API.
+func (r SummaryProbesRequest) Valid() error {
+	if r.Id == 0 {
+		return ErrMissingId
+	}
+
+	if r.From.IsZero() {
+		return fmt.Errorf("invalid value for `From`, must contain time")
+	}
+
+	return validTimeRange(r.From, r.To)
+}
+
+// GetParams returns a map of params for a Pingdom SummaryProbesRequest.
+func (r SummaryProbesRequest) GetParams() map[string]string {
+	return timeRangeParams(r.From, r.To)
+}
+
+func validTimeRange(from, to time.Time) error {
+	if !from.IsZero() && !to.IsZero() && !to.After(from) {
+		return ErrBadPeriod
+	}
+
+	return nil
+}
+
+// timeRangeParams returns the `from` and `to` params of a request, leaving
+// out the zero times.
+func timeRangeParams(from, to time.Time) map[string]string {
+	params := map[string]string{}
+
+	if !from.IsZero() {
+		params["from"] = strconv.FormatInt(from.Unix(), 10)
+	}
+
+	if !to.IsZero() {
+		params["to"] = strconv.FormatInt(to.Unix(), 10)
+	}
+
+	return params
+}
@@ -0,0 +1,68 @@
+package pingdom
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummaryAverageRequestValid(t *testing.T) {
+	from := time.Unix(1600000000, 0)
+
+	assert.Equal(t, ErrMissingId, SummaryAverageRequest{}.Valid())
+	assert.NoError(t, SummaryAverageRequest{Id: 1}.Valid())
+	assert.Error(t, SummaryAverageRequest{Id: 1, ByCountry: true, ByProbe: true}.Valid())
+	assert.Equal(t, ErrBadPeriod, SummaryAverageRequest{Id: 1, From: from, To: from}.Valid())
+}
+
+func TestSummaryAverageRequestGetParams(t *testing.T) {
+	params := SummaryAverageRequest{
+		Id:            1,
+		From:          time.Unix(1600000000, 0),
+		To:            time.Unix(1600003600, 0),
+		Probes:        []int{28, 29},
+		IncludeUptime: true,
+		ByCountry:     true,
+	}.GetParams()
+
+	assert.Equal(t, map[string]string{
+		"from":          "1600000000",
+		"to":            "1600003600",
+		"probes":        "28,29",
+		"includeuptime": "true",
+		"bycountry":     "true",
+	}, params)
+}
+
+func TestSummaryOutageRequestValid(t *testing.T) {
+	assert.Equal(t, ErrMissingId, SummaryOutageRequest{}.Valid())
+	assert.NoError(t, SummaryOutageRequest{Id: 1, Order: "asc"}.Valid())
+	assert.Equal(t, ErrBadOrder, SummaryOutageRequest{Id: 1, Order: "up"}.Valid())
+}
+
+func TestSummaryHoursOfDayRequestGetParams(t *testing.T) {
+	assert.Equal(t, map[string]string{}, SummaryHoursOfDayRequest{Id: 1}.GetParams())
+	assert.Equal(t, map[string]string{
+		"probes":       "28",
+		"uselocaltime": "true",
+	}, SummaryHoursOfDayRequest{Id: 1, Probes: []int{28}, UseLocalTime: true}.GetParams())
+}
+
+func TestSummaryProbesRequestValid(t *testing.T) {
+	assert.Equal(t, ErrMissingId, SummaryProbesRequest{}.Valid())
+	assert.Error(t, SummaryProbesRequest{Id: 1}.Valid())
+	assert.NoError(t, SummaryProbesRequest{Id: 1, From: time.Unix(1600000000, 0)}.Valid())
+}
+
+func TestSummaryAverageResponseTimeUnmarshal(t *testing.T) {
+	var plain SummaryAverageResponseTime
+	assert.NoError(t, json.Unmarshal([]byte(`{"from":1,"to":2,"avgresponse":646}`), &plain))
+	assert.Equal(t, SummaryAverageResponseTime{From: 1, To: 2, AvgResponse: 646}, plain)
+
+	var byCountry SummaryAverageResponseTime
+	assert.NoError(t, json.Unmarshal([]byte(`{"from":1,"to":2,"avgresponse":[{"countryiso":"SE","avgresponse":90}]}`), &byCountry))
+	assert.Equal(t, []SummaryAverageCountry{{CountryISO: "SE", AvgResponse: 90}}, byCountry.ByCountry)
+	assert.Nil(t, byCountry.ByProbe)
+}
@@ -1,8 +1,7 @@
 package pingdom
 
 import (
-	"encoding/json"
-	"io/ioutil"
+	"context"
 	"strconv"
 )
 
@@ -19,33 +18,33 @@ type TeamAPI interface {
 
 // List return a list of teams from Pingdom.
 func (cs *TeamService) List() ([]TeamResponse, error) {
-	req, err := cs.client.NewRequest("GET", "/alerting/teams", nil)
-	if err != nil {
-		return nil, err
-	}
+	return cs.ListContext(context.Background())
+}
 
-	resp, err := cs.client.client.Do(req)
+// ListContext is like List but uses the given context for the request.
+func (cs *TeamService) ListContext(ctx context.Context) ([]TeamResponse, error) {
+	req, err := cs.client.NewRequestWithContext(ctx, "GET", "/alerting/teams", nil)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
 
-	if err := validateResponse(resp); err != nil {
+	t := &listTeamsJSONResponse{}
+	_, err = cs.client.Do(req, t)
+	if err != nil {
 		return nil, err
 	}
 
-	bodyBytes, _ := ioutil.ReadAll(resp.Body)
-	bodyString := string(bodyBytes)
-
-	t := &listTeamsJSONResponse{}
-	err = json.Unmarshal([]byte(bodyString), &t)
-
 	return t.Teams, err
 }
 
 // Read return a team object from Pingdom.
 func (cs *TeamService) Read(id int) (*TeamResponse, error) {
-	req, err := cs.client.NewRequest("GET", "/alerting/teams/"+strconv.Itoa(id), nil)
+	return cs.ReadContext(context.Background(), id)
+}
+
+// ReadContext is like Read but uses the given context for the request.
+func (cs *TeamService) ReadContext(ctx context.Context, id int) (*TeamResponse, error) {
+	req, err := cs.client.NewRequestWithContext(ctx, "GET", "/alerting/teams/"+strconv.Itoa(id), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -61,11 +60,16 @@ func (cs *TeamService) Read(id int) (*TeamResponse, error) {
 
 // Create is used to create a new team.
 func (cs *TeamService) Create(team TeamAPI) (*TeamResponse, error) {
+	return cs.CreateContext(context.Background(), team)
+}
+
+// CreateContext is like Create but uses the given context for the request.
+func (cs *TeamService) CreateContext(ctx context.Context, team TeamAPI) (*TeamResponse, error) {
 	if err := team.Valid(); err != nil {
 		return nil, err
 	}
 
-	req, err := cs.client.NewJSONRequest("POST", "/alerting/teams", team.RenderForJSONAPI())
+	req, err := cs.client.NewJSONRequestWithContext(ctx, "POST", "/alerting/teams", team.RenderForJSONAPI())
 	if err != nil {
 		return nil, err
 	}
@@ -80,7 +84,12 @@ func (cs *TeamService) Create(team TeamAPI) (*TeamResponse, error) {
 
 // Update is used to update existing team.
 func (cs *TeamService) Update(id int, team TeamAPI) (*TeamResponse, error) {
-	req, err := cs.client.NewJSONRequest("PUT", "/alerting/teams/"+strconv.Itoa(id), team.RenderForJSONAPI())
+	return cs.UpdateContext(context.Background(), id, team)
+}
+
+// UpdateContext is like Update but uses the given context for the request.
+func (cs *TeamService) UpdateContext(ctx context.Context, id int, team TeamAPI) (*TeamResponse, error) {
+	req, err := cs.client.NewJSONRequestWithContext(ctx, "PUT", "/alerting/teams/"+strconv.Itoa(id), team.RenderForJSONAPI())
 	if err != nil {
 		return nil, err
 	}
@@ -95,7 +104,12 @@ func (cs *TeamService) Update(id int, team TeamAPI) (*TeamResponse, error) {
 
 // Delete will delete the Team for the given ID.
 func (cs *TeamService) Delete(id int) (*TeamDeleteResponse, error) {
-	req, err := cs.client.NewRequest("DELETE", "/alerting/teams/"+strconv.Itoa(id), nil)
+	return cs.DeleteContext(context.Background(), id)
+}
+
+// DeleteContext is like Delete but uses the given context for the request.
+func (cs *TeamService) DeleteContext(ctx context.Context, id int) (*TeamDeleteResponse, error) {
+	req, err := cs.client.NewRequestWithContext(ctx, "DELETE", "/alerting/teams/"+strconv.Itoa(id), nil)
 	if err != nil {
 		return nil, err
 	}
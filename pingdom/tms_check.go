@@ -1,8 +1,7 @@
 package pingdom
 
 import (
-	"encoding/json"
-	"io/ioutil"
+	"context"
 	"strconv"
 )
 
@@ -18,66 +17,69 @@ type TMSCheckSAPI interface {
 
 // List return a list of TMS checks from Pingdom.
 func (cs *TMSCheckService) List(params ...map[string]string) ([]TMSCheckResponse, error) {
+	return cs.ListContext(context.Background(), params...)
+}
 
-	param := map[string]string{}
-	if len(params) == 1 {
-		param = params[0]
-	}
-	req, err := cs.client.NewRequest("GET", "/tms/check", param)
+// ListContext is like List but uses the given context for the request.
+func (cs *TMSCheckService) ListContext(ctx context.Context, params ...map[string]string) ([]TMSCheckResponse, error) {
+	param := mergeParams(params)
+	req, err := cs.client.NewRequestWithContext(ctx, "GET", "/tms/check", param)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := cs.client.client.Do(req)
+	t := &listTMSChecksJSONResponse{}
+	_, err = cs.client.Do(req, t)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
 
-	if err := validateResponse(resp); err != nil {
-		return nil, err
-	}
-
-	bodyBytes, _ := ioutil.ReadAll(resp.Body)
-	bodyString := string(bodyBytes)
+	return t.TMSChecks, err
+}
 
-	t := &listTMSChecksJSONResponse{}
-	err = json.Unmarshal([]byte(bodyString), &t)
+// ListWithOptions returns a list of TMS checks from Pingdom filtered
+// according to the given options.
+func (cs *TMSCheckService) ListWithOptions(opts ListTMSChecksOptions) ([]TMSCheckResponse, error) {
+	return cs.ListWithOptionsContext(context.Background(), opts)
+}
 
-	return t.TMSChecks, err
+// ListWithOptionsContext is like ListWithOptions but uses the given context
+// for the request.
+func (cs *TMSCheckService) ListWithOptionsContext(ctx context.Context, opts ListTMSChecksOptions) ([]TMSCheckResponse, error) {
+	return cs.ListContext(ctx, opts.Params())
 }
 
 func (cs *TMSCheckService) Read(id int) (*TMSCheckDetailResponse, error) {
-	req, err := cs.client.NewRequest("GET", "/tms/check/"+strconv.Itoa(id), nil)
-	if err != nil {
-		return nil, err
-	}
+	return cs.ReadContext(context.Background(), id)
+}
 
-	resp, err := cs.client.client.Do(req)
+// ReadContext is like Read but uses the given context for the request.
+func (cs *TMSCheckService) ReadContext(ctx context.Context, id int) (*TMSCheckDetailResponse, error) {
+	req, err := cs.client.NewRequestWithContext(ctx, "GET", "/tms/check/"+strconv.Itoa(id), nil)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
 
-	if err := validateResponse(resp); err != nil {
+	t := &tmsChecksDetailJSONResponse{}
+	_, err = cs.client.Do(req, t)
+	if err != nil {
 		return nil, err
 	}
 
-	bodyBytes, _ := ioutil.ReadAll(resp.Body)
-	bodyString := string(bodyBytes)
-
-	t := &tmsChecksDetailJSONResponse{}
-	err = json.Unmarshal([]byte(bodyString), &t)
-
 	return t.TMSCheck, err
 }
 
 func (cs *TMSCheckService) Create(tmsCheck *TMSCheck) (*TMSCheckDetailResponse, error) {
+	return cs.CreateContext(context.Background(), tmsCheck)
+}
+
+// CreateContext is like Create but uses the given context for the request.
+func (cs *TMSCheckService) CreateContext(ctx context.Context, tmsCheck *TMSCheck) (*TMSCheckDetailResponse, error) {
 	if err := tmsCheck.Valid(); err != nil {
 		return nil, err
 	}
 
-	req, err := cs.client.NewJSONRequest("POST", "/tms/check", tmsCheck.RenderForJSONAPI())
+	req, err := cs.client.NewJSONRequestWithContext(ctx, "POST", "/tms/check", tmsCheck.RenderForJSONAPI())
 	if err != nil {
 		return nil, err
 	}
@@ -91,11 +93,16 @@ func (cs *TMSCheckService) Create(tmsCheck *TMSCheck) (*TMSCheckDetailResponse,
 }
 
 func (cs *TMSCheckService) Update(id int, tmsCheck *TMSCheck) (*TMSCheckDetailResponse, error) {
+	return cs.UpdateContext(context.Background(), id, tmsCheck)
+}
+
+// UpdateContext is like Update but uses the given context for the request.
+func (cs *TMSCheckService) UpdateContext(ctx context.Context, id int, tmsCheck *TMSCheck) (*TMSCheckDetailResponse, error) {
 	if err := tmsCheck.Valid(); err != nil {
 		return nil, err
 	}
 
-	req, err := cs.client.NewJSONRequest("PUT", "/tms/check/"+strconv.Itoa(id), tmsCheck.RenderForJSONAPI())
+	req, err := cs.client.NewJSONRequestWithContext(ctx, "PUT", "/tms/check/"+strconv.Itoa(id), tmsCheck.RenderForJSONAPI())
 	if err != nil {
 		return nil, err
 	}
@@ -110,7 +117,12 @@ func (cs *TMSCheckService) Update(id int, tmsCheck *TMSCheck) (*TMSCheckDetailRe
 }
 
 func (cs *TMSCheckService) Delete(id int) (*PingdomResponse, error) {
-	req, err := cs.client.NewRequest("DELETE", "/tms/check/"+strconv.Itoa(id), nil)
+	return cs.DeleteContext(context.Background(), id)
+}
+
+// DeleteContext is like Delete but uses the given context for the request.
+func (cs *TMSCheckService) DeleteContext(ctx context.Context, id int) (*PingdomResponse, error) {
+	req, err := cs.client.NewRequestWithContext(ctx, "DELETE", "/tms/check/"+strconv.Itoa(id), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -124,7 +136,13 @@ func (cs *TMSCheckService) Delete(id int) (*PingdomResponse, error) {
 }
 
 func (cs *TMSCheckService) GetStatusReport(id int, params map[string]string) (*TMSCheckStatusReportResponse, error) {
-	req, err := cs.client.NewRequest("GET", "/tms/check/"+strconv.Itoa(id)+"/report/status", params)
+	return cs.GetStatusReportContext(context.Background(), id, params)
+}
+
+// GetStatusReportContext is like GetStatusReport but uses the given context
+// for the request.
+func (cs *TMSCheckService) GetStatusReportContext(ctx context.Context, id int, params map[string]string) (*TMSCheckStatusReportResponse, error) {
+	req, err := cs.client.NewRequestWithContext(ctx, "GET", "/tms/check/"+strconv.Itoa(id)+"/report/status", params)
 	if err != nil {
 		return nil, err
 	}
@@ -138,7 +156,13 @@ func (cs *TMSCheckService) GetStatusReport(id int, params map[string]string) (*T
 }
 
 func (cs *TMSCheckService) ListStatusReports(params map[string]string) ([]TMSCheckStatusReportResponse, error) {
-	req, err := cs.client.NewRequest("GET", "/tms/check/report/status", params)
+	return cs.ListStatusReportsContext(context.Background(), params)
+}
+
+// ListStatusReportsContext is like ListStatusReports but uses the given
+// context for the request.
+func (cs *TMSCheckService) ListStatusReportsContext(ctx context.Context, params map[string]string) ([]TMSCheckStatusReportResponse, error) {
+	req, err := cs.client.NewRequestWithContext(ctx, "GET", "/tms/check/report/status", params)
 	if err != nil {
 		return nil, err
 	}
@@ -152,7 +176,13 @@ func (cs *TMSCheckService) ListStatusReports(params map[string]string) ([]TMSChe
 }
 
 func (cs *TMSCheckService) GetPerfomanceReport(id int, params map[string]string) (*TMSCheckPerformanceReportResponse, error) {
-	req, err := cs.client.NewRequest("GET", "/tms/check/"+strconv.Itoa(id)+"/report/performance", params)
+	return cs.GetPerfomanceReportContext(context.Background(), id, params)
+}
+
+// GetPerfomanceReportContext is like GetPerfomanceReport but uses the given
+// context for the request.
+func (cs *TMSCheckService) GetPerfomanceReportContext(ctx context.Context, id int, params map[string]string) (*TMSCheckPerformanceReportResponse, error) {
+	req, err := cs.client.NewRequestWithContext(ctx, "GET", "/tms/check/"+strconv.Itoa(id)+"/report/performance", params)
 	if err != nil {
 		return nil, err
 	}
@@ -969,3 +969,23 @@ func TestTMSCheckService_GetPerfomanceReport(t *testing.T) {
 		})
 	}
 }
+
+func TestTMSCheckService_ListMergesParams(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/tms/check", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got := r.URL.Query().Get("limit"); got != "10" {
+			t.Errorf("limit = %q, want 10", got)
+		}
+		if got := r.URL.Query().Get("type"); got != "script" {
+			t.Errorf("type = %q, want script", got)
+		}
+		fmt.Fprint(w, `{"checks": []}`)
+	})
+
+	if _, err := client.TMSCheck.List(map[string]string{"limit": "10"}, map[string]string{"type": "script"}); err != nil {
+		t.Errorf("TMSCheck.List returned error: %v", err)
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"regexp"
+	"strconv"
+	"strings"
 )
 
 type TMSCheck struct {
@@ -34,6 +36,19 @@ type TMSCheckMetaData struct {
 	Width              int         `json:"width,omitempty"`
 }
 
+// ListTMSChecksOptions are the parameters of a Pingdom TMS check list
+// request.  Zero values are not sent.
+type ListTMSChecksOptions struct {
+	Limit        int
+	Offset       int
+	ExtendedTags bool
+	// Tags restricts the list to checks with any of the given tags.
+	Tags []string
+	// Type restricts the list to checks of the given type, "script" or
+	// "recording".
+	Type string
+}
+
 // RenderForJSONAPI returns the JSON formatted version of this object that may be submitted to Pingdom
 func (t *TMSCheck) RenderForJSONAPI() string {
 	jsonBody, _ := json.Marshal(t)
@@ -78,3 +93,44 @@ func (t *TMSCheck) Valid() error {
 
 	return nil
 }
+
+// Params returns the query parameters of a ListTMSChecksOptions.
+func (o ListTMSChecksOptions) Params() map[string]string {
+	m := map[string]string{}
+
+	if o.Limit != 0 {
+		m["limit"] = strconv.Itoa(o.Limit)
+	}
+
+	if o.Offset != 0 {
+		m["offset"] = strconv.Itoa(o.Offset)
+	}
+
+	if o.ExtendedTags {
+		m["extended_tags"] = "true"
+	}
+
+	if len(o.Tags) != 0 {
+		m["tags"] = strings.Join(o.Tags, ",")
+	}
+
+	if o.Type != "" {
+		m["type"] = o.Type
+	}
+
+	return m
+}
+
+// tmsRegions are the regions transaction checks can run from, as documented
+// by Pingdom.  The API does not expose them, not even in the reference data.
+var tmsRegions = []string{"us-east", "us-west", "eu", "au"}
+
+// ValidTMSRegion checks the region of a TMSCheck against the regions
+// documented by Pingdom.  An empty region is valid and lets Pingdom choose.
+func ValidTMSRegion(region string) error {
+	if region == "" || stringInSlice(region, tmsRegions) {
+		return nil
+	}
+
+	return fmt.Errorf("Invalid value for `Region`. Please provide one of the following valid values instead: [%s].", strings.Join(tmsRegions, ","))
+}
@@ -242,3 +242,29 @@ func TestTMSCheck_RenderForJSONAPI(t *testing.T) {
 		})
 	}
 }
+
+func TestListTMSChecksOptionsParams(t *testing.T) {
+	assert.Equal(t, map[string]string{}, ListTMSChecksOptions{}.Params())
+
+	opts := ListTMSChecksOptions{
+		Limit:        10,
+		Offset:       20,
+		ExtendedTags: true,
+		Tags:         []string{"a", "b"},
+		Type:         "script",
+	}
+	want := map[string]string{
+		"limit":         "10",
+		"offset":        "20",
+		"extended_tags": "true",
+		"tags":          "a,b",
+		"type":          "script",
+	}
+	assert.Equal(t, want, opts.Params())
+}
+
+func TestValidTMSRegion(t *testing.T) {
+	assert.NoError(t, ValidTMSRegion(""))
+	assert.NoError(t, ValidTMSRegion("us-east"))
+	assert.Error(t, ValidTMSRegion("us-north"))
+}